@@ -3,6 +3,7 @@ package main
 import (
 	"Wordle/internal/server"
 	"fmt"
+	"net"
 	"os"
 	"strconv"
 
@@ -14,6 +15,20 @@ func main() {
 	server := server.New()
 
 	server.RegisterFiberRoutes()
+
+	// The gRPC API is optional and listens next to the REST one
+	if grpcPort, _ := strconv.Atoi(os.Getenv("GRPC_PORT")); grpcPort != 0 {
+		lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
+		if err != nil {
+			panic(fmt.Sprintf("cannot start gRPC server: %s", err))
+		}
+		go func() {
+			if err := server.GRPC().Serve(lis); err != nil {
+				panic(fmt.Sprintf("cannot start gRPC server: %s", err))
+			}
+		}()
+	}
+
 	port, _ := strconv.Atoi(os.Getenv("PORT"))
 	err := server.Listen(fmt.Sprintf(":%d", port))
 	if err != nil {
@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestPassword(t *testing.T) {
+	hash, err := HashPassword("correct horse")
+	if err != nil {
+		t.Fatalf("HashPassword returned error: %v", err)
+	}
+	if hash == "correct horse" {
+		t.Fatalf("HashPassword returned the plaintext password")
+	}
+
+	if err := CheckPassword(hash, "correct horse"); err != nil {
+		t.Errorf("CheckPassword with the right password returned error: %v", err)
+	}
+	if err := CheckPassword(hash, "battery staple"); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("CheckPassword with the wrong password error = %v; want %v", err, ErrInvalidCredentials)
+	}
+}
+
+func TestPasswordTooLong(t *testing.T) {
+	if _, err := HashPassword(strings.Repeat("€", 30)); !errors.Is(err, ErrPasswordTooLong) {
+		t.Errorf("HashPassword of a 90 byte password error = %v; want %v", err, ErrPasswordTooLong)
+	}
+}
+
+func TestRejectPassword(t *testing.T) {
+	// The dummy hash must cost as much to compare as a real one
+	cost, err := bcrypt.Cost([]byte(dummyHash))
+	if err != nil || cost != bcrypt.DefaultCost {
+		t.Errorf("dummy hash cost = %d, %v; want %d", cost, err, bcrypt.DefaultCost)
+	}
+	if err := RejectPassword("correct horse"); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("RejectPassword error = %v; want %v", err, ErrInvalidCredentials)
+	}
+}
+
+func TestTokens(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tokens := NewTokens([]byte("secret"), time.Hour)
+	tokens.now = func() time.Time { return now }
+
+	token, expires, err := tokens.Issue("user-1")
+	if err != nil {
+		t.Fatalf("Issue returned error: %v", err)
+	}
+	if !expires.Equal(now.Add(time.Hour)) {
+		t.Errorf("Issue expiry = %v; want %v", expires, now.Add(time.Hour))
+	}
+
+	parts := strings.Split(token, ".")
+	tests := []struct {
+		name        string
+		token       string
+		verifier    *Tokens
+		at          time.Time
+		expectedID  string
+		expectedErr error
+	}{
+		{
+			name:       "Valid token",
+			token:      token,
+			verifier:   tokens,
+			at:         now.Add(time.Minute),
+			expectedID: "user-1",
+		},
+		{
+			name:        "Expired token",
+			token:       token,
+			verifier:    tokens,
+			at:          now.Add(2 * time.Hour),
+			expectedErr: ErrExpiredToken,
+		},
+		{
+			name:        "Different secret",
+			token:       token,
+			verifier:    NewTokens([]byte("other"), time.Hour),
+			at:          now,
+			expectedErr: ErrInvalidToken,
+		},
+		{
+			name:        "Tampered payload",
+			token:       parts[0] + "." + parts[1] + "x." + parts[2],
+			verifier:    tokens,
+			at:          now,
+			expectedErr: ErrInvalidToken,
+		},
+		{
+			name:        "Malformed token",
+			token:       "not-a-token",
+			verifier:    tokens,
+			at:          now,
+			expectedErr: ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			at := tt.at
+			tt.verifier.now = func() time.Time { return at }
+
+			id, err := tt.verifier.Verify(tt.token)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Errorf("Verify() error = %v; want %v", err, tt.expectedErr)
+			}
+			if id != tt.expectedID {
+				t.Errorf("Verify() = %q; want %q", id, tt.expectedID)
+			}
+		})
+	}
+}
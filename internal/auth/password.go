@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"errors"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid username or password")
+	ErrPasswordTooLong    = errors.New("password is longer than 72 bytes")
+)
+
+// HashPassword hashes a password with bcrypt at the default cost. bcrypt
+// refuses passwords longer than 72 bytes, which fail with ErrPasswordTooLong.
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if errors.Is(err, bcrypt.ErrPasswordTooLong) {
+		return "", ErrPasswordTooLong
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// CheckPassword compares a password with a bcrypt hash, returning
+// ErrInvalidCredentials when they do not match.
+func CheckPassword(hash, password string) error {
+	if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)); err != nil {
+		return ErrInvalidCredentials
+	}
+	return nil
+}
+
+// dummyHash is a bcrypt hash at the default cost of a password nobody uses.
+const dummyHash = "$2a$10$WLXF3.bUZ8u1L3piFe1jQ.wEJqYEVUvk8l2/0jEVicoOOokvW91eW"
+
+// RejectPassword takes as long as CheckPassword and always returns
+// ErrInvalidCredentials. Logins for unknown usernames call it, so they cannot
+// be told apart from wrong passwords by their response time.
+func RejectPassword(password string) error {
+	bcrypt.CompareHashAndPassword([]byte(dummyHash), []byte(password))
+	return ErrInvalidCredentials
+}
@@ -0,0 +1,98 @@
+// Package auth hashes passwords and issues the signed session tokens players
+// send in the Authorization header.
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+// DefaultTokenTTL is how long an issued token stays valid.
+const DefaultTokenTTL = 7 * 24 * time.Hour
+
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrExpiredToken = errors.New("token has expired")
+)
+
+// tokenHeader is the fixed JOSE header of every token: HMAC-SHA256 signed JWTs.
+var tokenHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+type claims struct {
+	Subject   string `json:"sub"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// Tokens issues and verifies HS256 JWTs whose subject is a user ID.
+type Tokens struct {
+	secret []byte
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+func NewTokens(secret []byte, ttl time.Duration) *Tokens {
+	if ttl == 0 {
+		ttl = DefaultTokenTTL
+	}
+	return &Tokens{
+		secret: secret,
+		ttl:    ttl,
+		now:    time.Now,
+	}
+}
+
+// Issue returns a signed token for the user and the moment it expires.
+func (t *Tokens) Issue(userID string) (string, time.Time, error) {
+	now := t.now()
+	expires := now.Add(t.ttl)
+
+	payload, err := json.Marshal(claims{
+		Subject:   userID,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expires.Unix(),
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	unsigned := tokenHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return unsigned + "." + t.sign(unsigned), expires, nil
+}
+
+// Verify checks a token's signature and expiry and returns its user ID.
+func (t *Tokens) Verify(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != tokenHeader {
+		return "", ErrInvalidToken
+	}
+
+	expected := t.sign(parts[0] + "." + parts[1])
+	if !hmac.Equal([]byte(parts[2]), []byte(expected)) {
+		return "", ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+	var c claims
+	if err := json.Unmarshal(payload, &c); err != nil || c.Subject == "" {
+		return "", ErrInvalidToken
+	}
+	if t.now().Unix() >= c.ExpiresAt {
+		return "", ErrExpiredToken
+	}
+	return c.Subject, nil
+}
+
+func (t *Tokens) sign(unsigned string) string {
+	mac := hmac.New(sha256.New, t.secret)
+	mac.Write([]byte(unsigned))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
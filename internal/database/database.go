@@ -2,28 +2,111 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"sync/atomic"
 	"time"
 
+	"Wordle/internal/models"
+
 	_ "github.com/joho/godotenv/autoload"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+var (
+	ErrNotFound  = errors.New("record not found")
+	ErrDuplicate = errors.New("record already exists")
+	ErrConflict  = errors.New("record was modified concurrently")
+)
+
 type Service interface {
-	Health() map[string]string
+	// Health pings the database. It fails when the database cannot be
+	// reached before ctx is done.
+	Health(ctx context.Context) error
+
+	CreateGame(ctx context.Context, g *models.Game) error
+	GetGame(ctx context.Context, id string) (*models.Game, error)
+	// UpdateGame replaces a stored game. It fails with ErrConflict when the
+	// game was updated by someone else since it was loaded.
+	UpdateGame(ctx context.Context, g *models.Game) error
+
+	CreateUser(ctx context.Context, u *models.User) error
+	// GetUser and GetUserByUsername load a user with the words they submitted.
+	GetUser(ctx context.Context, id string) (*models.User, error)
+	GetUserByUsername(ctx context.Context, username string) (*models.User, error)
+	// LookupUser loads a user without their words, for the authentication
+	// check every request makes.
+	LookupUser(ctx context.Context, id string) (*models.User, error)
+
+	CreateWord(ctx context.Context, w *models.Word) error
+	GetWord(ctx context.Context, id string) (*models.Word, error)
+	ListWordsByUser(ctx context.Context, userID string) ([]models.Word, error)
+	// ListWordsByStatus lists words oldest first; an empty status lists every word.
+	ListWordsByStatus(ctx context.Context, status string) ([]models.Word, error)
+	// ReviewWord moves a pending word to the given status. It fails with
+	// ErrConflict when the word has already been reviewed.
+	ReviewWord(ctx context.Context, id, status string) (*models.Word, error)
+
+	// SetWordTier stores an admin tier override, replacing any earlier one for the same word.
+	SetWordTier(ctx context.Context, t *models.WordTier) error
+	ListWordTiers(ctx context.Context) ([]models.WordTier, error)
+
+	// GetStats returns ErrNotFound for a player who has not finished a game yet.
+	GetStats(ctx context.Context, userID string) (*models.Stats, error)
+	// SaveStats creates or replaces a player's stats. It fails with
+	// ErrConflict when they were saved by someone else since they were loaded.
+	SaveStats(ctx context.Context, st *models.Stats) error
+
+	CreateChallenge(ctx context.Context, ch *models.Challenge) error
+	GetChallenge(ctx context.Context, id string) (*models.Challenge, error)
+	// ListChallengesByUser lists a creator's challenges, newest first.
+	ListChallengesByUser(ctx context.Context, userID string) ([]models.Challenge, error)
+	// IncrementChallenge atomically adds to a challenge's attempt and solve counters.
+	IncrementChallenge(ctx context.Context, id string, attempts, solves int) error
+
+	// DailyLeaderboard ranks the players who solved a daily puzzle on their
+	// first game for it (by creation time, then game ID), by guesses, then
+	// solve time, then finish time, then user ID. Seeded games never appear, and neither do players whose first
+	// game was hinted.
+	DailyLeaderboard(ctx context.Context, puzzle int, page Page) ([]models.LeaderboardEntry, error)
+	// WinRateLeaderboard ranks players with at least minPlayed games by win
+	// rate, then games played, then user ID.
+	WinRateLeaderboard(ctx context.Context, minPlayed int, page Page) ([]models.LeaderboardEntry, error)
+	// StreakLeaderboard ranks players by longest streak, then user ID.
+	StreakLeaderboard(ctx context.Context, page Page) ([]models.LeaderboardEntry, error)
+	// DailyResults counts every finished game of a daily puzzle, anonymous
+	// ones included. Seeded and hinted games never count.
+	DailyResults(ctx context.Context, puzzle int) (*models.DailyResults, error)
+}
+
+// Page selects a window of a ranked listing.
+type Page struct {
+	Offset int
+	Limit  int
 }
 
 type service struct {
 	db *mongo.Client
+
+	games      *mongo.Collection
+	users      *mongo.Collection
+	words      *mongo.Collection
+	wordTiers  *mongo.Collection
+	stats      *mongo.Collection
+	challenges *mongo.Collection
+
+	indexed atomic.Bool // Whether ensureIndexes has succeeded
 }
 
 var (
-	host = os.Getenv("DB_HOST")
-	port = os.Getenv("DB_PORT")
-	//database = os.Getenv("DB_DATABASE")
+	host     = os.Getenv("DB_HOST")
+	port     = os.Getenv("DB_PORT")
+	database = os.Getenv("DB_DATABASE")
 )
 
 func New() Service {
@@ -33,21 +116,456 @@ func New() Service {
 		log.Fatal(err)
 
 	}
-	return &service{
-		db: client,
+
+	name := database
+	if name == "" {
+		name = "wordle"
+	}
+	db := client.Database(name)
+
+	s := &service{
+		db:         client,
+		games:      db.Collection("games"),
+		users:      db.Collection("users"),
+		words:      db.Collection("words"),
+		wordTiers:  db.Collection("word_tiers"),
+		stats:      db.Collection("stats"),
+		challenges: db.Collection("challenges"),
 	}
-}
 
-func (s *service) Health() map[string]string {
-	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
+	if err := s.ensureIndexes(ctx); err != nil {
+		// Start anyway: the server reports itself not ready until Health
+		// manages to create them
+		log.Printf("failed to create indexes: %v", err)
+	} else {
+		s.indexed.Store(true)
+	}
+
+	return s
+}
+
+// ensureIndexes creates the indexes every query in this package relies on.
+// Index creation is idempotent, so this is safe to run on every startup.
+func (s *service) ensureIndexes(ctx context.Context) error {
+	if _, err := s.users.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "username", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}); err != nil {
+		return err
+	}
+
+	if _, err := s.games.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "updated_at", Value: -1}}},
+		{
+			Keys: bson.D{{Key: "puzzle_number", Value: 1}, {Key: "user_id", Value: 1}, {Key: "created_at", Value: 1}},
+			Options: options.Index().SetPartialFilterExpression(bson.M{
+				"puzzle_number": bson.M{"$gt": 0},
+				"user_id":       bson.M{"$exists": true},
+			}),
+		},
+		{
+			Keys:    bson.D{{Key: "puzzle_number", Value: 1}, {Key: "status", Value: 1}},
+			Options: options.Index().SetPartialFilterExpression(bson.M{"puzzle_number": bson.M{"$gt": 0}}),
+		},
+	}); err != nil {
+		return err
+	}
+
+	if _, err := s.words.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "content", Value: 1}}},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "created_at", Value: 1}}},
+	}); err != nil {
+		return err
+	}
+
+	if _, err := s.challenges.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "creator_id", Value: 1}, {Key: "created_at", Value: -1}},
+	}); err != nil {
+		return err
+	}
+
+	if _, err := s.stats.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "max_streak", Value: -1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "played", Value: 1}}},
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) Health(ctx context.Context) error {
+	if err := s.db.Ping(ctx, nil); err != nil {
+		return err
+	}
+	if !s.indexed.Load() {
+		if err := s.ensureIndexes(ctx); err != nil {
+			return fmt.Errorf("failed to create indexes: %w", err)
+		}
+		s.indexed.Store(true)
+	}
+	return nil
+}
+
+func (s *service) CreateGame(ctx context.Context, g *models.Game) error {
+	_, err := s.games.InsertOne(ctx, g)
+	return translateError(err)
+}
+
+func (s *service) GetGame(ctx context.Context, id string) (*models.Game, error) {
+	var g models.Game
+	if err := s.games.FindOne(ctx, bson.M{"_id": id}).Decode(&g); err != nil {
+		return nil, translateError(err)
+	}
+	return &g, nil
+}
+
+func (s *service) UpdateGame(ctx context.Context, g *models.Game) error {
+	expected := g.Version
+	g.Version++
 
-	err := s.db.Ping(ctx, nil)
+	res, err := s.games.ReplaceOne(ctx, bson.M{"_id": g.ID, "version": expected}, g)
+	if err != nil {
+		g.Version = expected
+		return translateError(err)
+	}
+	if res.MatchedCount == 0 {
+		g.Version = expected
+		if n, _ := s.games.CountDocuments(ctx, bson.M{"_id": g.ID}); n == 0 {
+			return ErrNotFound
+		}
+		return ErrConflict
+	}
+	return nil
+}
+
+func (s *service) CreateUser(ctx context.Context, u *models.User) error {
+	if u.ID == "" {
+		u.ID = primitive.NewObjectID().Hex()
+	}
+	now := time.Now().UTC()
+	u.CreatedAt, u.UpdatedAt = now, now
+
+	_, err := s.users.InsertOne(ctx, u)
+	return translateError(err)
+}
+
+func (s *service) GetUser(ctx context.Context, id string) (*models.User, error) {
+	return s.findUser(ctx, bson.M{"_id": id}, true)
+}
+
+func (s *service) GetUserByUsername(ctx context.Context, username string) (*models.User, error) {
+	return s.findUser(ctx, bson.M{"username": username}, true)
+}
+
+func (s *service) LookupUser(ctx context.Context, id string) (*models.User, error) {
+	return s.findUser(ctx, bson.M{"_id": id}, false)
+}
+
+func (s *service) findUser(ctx context.Context, filter bson.M, withWords bool) (*models.User, error) {
+	var u models.User
+	if err := s.users.FindOne(ctx, filter).Decode(&u); err != nil {
+		return nil, translateError(err)
+	}
+	if !withWords {
+		return &u, nil
+	}
+
+	words, err := s.ListWordsByUser(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	u.Words = words
+	return &u, nil
+}
+
+func (s *service) CreateWord(ctx context.Context, w *models.Word) error {
+	if w.ID == "" {
+		w.ID = primitive.NewObjectID().Hex()
+	}
+	w.CreatedAt = time.Now().UTC()
+
+	_, err := s.words.InsertOne(ctx, w)
+	return translateError(err)
+}
+
+func (s *service) GetWord(ctx context.Context, id string) (*models.Word, error) {
+	var w models.Word
+	if err := s.words.FindOne(ctx, bson.M{"_id": id}).Decode(&w); err != nil {
+		return nil, translateError(err)
+	}
+	return &w, nil
+}
+
+func (s *service) ListWordsByStatus(ctx context.Context, status string) ([]models.Word, error) {
+	filter := bson.M{}
+	if status != "" {
+		filter["status"] = status
+	}
+	cursor, err := s.words.Find(ctx, filter,
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
 	if err != nil {
-		log.Fatalf(fmt.Sprintf("db down: %v", err))
+		return nil, translateError(err)
 	}
 
-	return map[string]string{
-		"message": "It's healthy",
+	words := []models.Word{}
+	if err := cursor.All(ctx, &words); err != nil {
+		return nil, translateError(err)
+	}
+	return words, nil
+}
+
+func (s *service) ReviewWord(ctx context.Context, id, status string) (*models.Word, error) {
+	var w models.Word
+	err := s.words.FindOneAndUpdate(ctx,
+		bson.M{"_id": id, "status": models.WordPending},
+		bson.M{"$set": bson.M{"status": status, "reviewed_at": time.Now().UTC()}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&w)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		if _, err := s.GetWord(ctx, id); err != nil {
+			return nil, err
+		}
+		return nil, ErrConflict
+	}
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &w, nil
+}
+
+func (s *service) ListWordsByUser(ctx context.Context, userID string) ([]models.Word, error) {
+	cursor, err := s.words.Find(ctx, bson.M{"user_id": userID},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}))
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	words := []models.Word{}
+	if err := cursor.All(ctx, &words); err != nil {
+		return nil, translateError(err)
+	}
+	return words, nil
+}
+
+func (s *service) SetWordTier(ctx context.Context, t *models.WordTier) error {
+	t.ID = t.Lang + ":" + t.Word
+	t.UpdatedAt = time.Now().UTC()
+
+	_, err := s.wordTiers.ReplaceOne(ctx, bson.M{"_id": t.ID}, t, options.Replace().SetUpsert(true))
+	return translateError(err)
+}
+
+func (s *service) ListWordTiers(ctx context.Context) ([]models.WordTier, error) {
+	cursor, err := s.wordTiers.Find(ctx, bson.M{},
+		options.Find().SetSort(bson.D{{Key: "updated_at", Value: 1}}))
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	tiers := []models.WordTier{}
+	if err := cursor.All(ctx, &tiers); err != nil {
+		return nil, translateError(err)
+	}
+	return tiers, nil
+}
+
+func (s *service) GetStats(ctx context.Context, userID string) (*models.Stats, error) {
+	var st models.Stats
+	if err := s.stats.FindOne(ctx, bson.M{"_id": userID}).Decode(&st); err != nil {
+		return nil, translateError(err)
+	}
+	return &st, nil
+}
+
+func (s *service) SaveStats(ctx context.Context, st *models.Stats) error {
+	expected := st.Version
+	st.Version++
+
+	if expected == 0 {
+		// A first save races other first saves on the _id instead of the version
+		if _, err := s.stats.InsertOne(ctx, st); err != nil {
+			st.Version = expected
+			if err = translateError(err); errors.Is(err, ErrDuplicate) {
+				return ErrConflict
+			}
+			return err
+		}
+		return nil
+	}
+
+	res, err := s.stats.ReplaceOne(ctx, bson.M{"_id": st.UserID, "version": expected}, st)
+	if err != nil {
+		st.Version = expected
+		return translateError(err)
+	}
+	if res.MatchedCount == 0 {
+		st.Version = expected
+		return ErrConflict
+	}
+	return nil
+}
+
+func (s *service) CreateChallenge(ctx context.Context, ch *models.Challenge) error {
+	ch.CreatedAt = time.Now().UTC()
+	_, err := s.challenges.InsertOne(ctx, ch)
+	return translateError(err)
+}
+
+func (s *service) GetChallenge(ctx context.Context, id string) (*models.Challenge, error) {
+	var ch models.Challenge
+	if err := s.challenges.FindOne(ctx, bson.M{"_id": id}).Decode(&ch); err != nil {
+		return nil, translateError(err)
+	}
+	return &ch, nil
+}
+
+func (s *service) ListChallengesByUser(ctx context.Context, userID string) ([]models.Challenge, error) {
+	cursor, err := s.challenges.Find(ctx, bson.M{"creator_id": userID},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}))
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	challenges := []models.Challenge{}
+	if err := cursor.All(ctx, &challenges); err != nil {
+		return nil, translateError(err)
+	}
+	return challenges, nil
+}
+
+func (s *service) IncrementChallenge(ctx context.Context, id string, attempts, solves int) error {
+	res, err := s.challenges.UpdateByID(ctx, id, bson.M{
+		"$inc": bson.M{"attempts": attempts, "solves": solves},
+	})
+	if err != nil {
+		return translateError(err)
+	}
+	if res.MatchedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *service) DailyLeaderboard(ctx context.Context, puzzle int, page Page) ([]models.LeaderboardEntry, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"puzzle_number": puzzle,
+			"user_id":       bson.M{"$exists": true},
+			"seeded":        bson.M{"$ne": true},
+		}}},
+		// Only a player's first game for the puzzle counts, the lowest ID
+		// among games created at the same instant
+		{{Key: "$sort", Value: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$group", Value: bson.M{"_id": "$user_id", "game": bson.M{"$first": "$$ROOT"}}}},
+		{{Key: "$match", Value: bson.M{"game.status": "won", "game.hinted": bson.M{"$ne": true}}}},
+		{{Key: "$project", Value: bson.M{
+			"guesses":     bson.M{"$size": "$game.guesses"},
+			"solve_ms":    bson.M{"$subtract": bson.A{"$game.finished_at", "$game.created_at"}},
+			"finished_at": "$game.finished_at",
+		}}},
+		{{Key: "$sort", Value: bson.D{
+			{Key: "guesses", Value: 1},
+			{Key: "solve_ms", Value: 1},
+			{Key: "finished_at", Value: 1},
+			{Key: "_id", Value: 1},
+		}}},
+	}
+	return s.leaderboard(ctx, s.games, pipeline, page)
+}
+
+func (s *service) WinRateLeaderboard(ctx context.Context, minPlayed int, page Page) ([]models.LeaderboardEntry, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"played": bson.M{"$gte": max(minPlayed, 1)}}}},
+		{{Key: "$addFields", Value: bson.M{"win_rate": bson.M{"$divide": bson.A{"$wins", "$played"}}}}},
+		{{Key: "$sort", Value: bson.D{
+			{Key: "win_rate", Value: -1},
+			{Key: "played", Value: -1},
+			{Key: "_id", Value: 1},
+		}}},
+		{{Key: "$project", Value: bson.M{"played": 1, "wins": 1, "max_streak": 1}}},
+	}
+	return s.leaderboard(ctx, s.stats, pipeline, page)
+}
+
+func (s *service) StreakLeaderboard(ctx context.Context, page Page) ([]models.LeaderboardEntry, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"max_streak": bson.M{"$gt": 0}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "max_streak", Value: -1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$project", Value: bson.M{"played": 1, "wins": 1, "max_streak": 1}}},
+	}
+	return s.leaderboard(ctx, s.stats, pipeline, page)
+}
+
+func (s *service) DailyResults(ctx context.Context, puzzle int) (*models.DailyResults, error) {
+	cursor, err := s.games.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"puzzle_number": puzzle,
+			"status":        bson.M{"$in": bson.A{"won", "lost"}},
+			"seeded":        bson.M{"$ne": true},
+			"hinted":        bson.M{"$ne": true},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":    nil,
+			"played": bson.M{"$sum": 1},
+			"solved": bson.M{"$sum": bson.M{"$cond": bson.A{bson.M{"$eq": bson.A{"$status", "won"}}, 1, 0}}},
+		}}},
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	var counts []models.DailyResults
+	if err := cursor.All(ctx, &counts); err != nil {
+		return nil, translateError(err)
+	}
+	results := &models.DailyResults{PuzzleNumber: puzzle}
+	if len(counts) > 0 {
+		results.Played, results.Solved = counts[0].Played, counts[0].Solved
+	}
+	return results, nil
+}
+
+// leaderboard pages through a ranking pipeline whose documents are keyed by
+// user ID and joins in each player's username.
+func (s *service) leaderboard(ctx context.Context, coll *mongo.Collection, pipeline mongo.Pipeline, page Page) ([]models.LeaderboardEntry, error) {
+	pipeline = append(pipeline,
+		bson.D{{Key: "$skip", Value: int64(page.Offset)}},
+		bson.D{{Key: "$limit", Value: int64(page.Limit)}},
+		bson.D{{Key: "$lookup", Value: bson.M{
+			"from":         s.users.Name(),
+			"localField":   "_id",
+			"foreignField": "_id",
+			"as":           "user",
+		}}},
+		bson.D{{Key: "$set", Value: bson.M{"username": bson.M{"$first": "$user.username"}}}},
+	)
+
+	cursor, err := coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	entries := []models.LeaderboardEntry{}
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, translateError(err)
+	}
+	return entries, nil
+}
+
+// translateError maps driver errors onto the package's sentinel errors.
+func translateError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return ErrNotFound
+	case mongo.IsDuplicateKeyError(err):
+		return ErrDuplicate
+	default:
+		return err
 	}
 }
@@ -0,0 +1,463 @@
+package database
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"Wordle/internal/models"
+	"Wordle/internal/response"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// memory is an in-process implementation of Service used by tests and local
+// runs without MongoDB. Records are copied on the way in and out so callers
+// never share state with the store.
+type memory struct {
+	mu sync.RWMutex
+
+	games      map[string]models.Game
+	users      map[string]models.User
+	words      map[string]models.Word
+	wordTiers  map[string]models.WordTier
+	stats      map[string]models.Stats
+	challenges map[string]models.Challenge
+}
+
+// NewMemory returns a Service that keeps all records in process memory.
+func NewMemory() Service {
+	return &memory{
+		games:      make(map[string]models.Game),
+		users:      make(map[string]models.User),
+		words:      make(map[string]models.Word),
+		wordTiers:  make(map[string]models.WordTier),
+		stats:      make(map[string]models.Stats),
+		challenges: make(map[string]models.Challenge),
+	}
+}
+
+func (m *memory) Health(_ context.Context) error {
+	return nil
+}
+
+func (m *memory) CreateGame(_ context.Context, g *models.Game) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.games[g.ID]; ok {
+		return ErrDuplicate
+	}
+	m.games[g.ID] = cloneGame(g)
+	return nil
+}
+
+func (m *memory) GetGame(_ context.Context, id string) (*models.Game, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	g, ok := m.games[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	c := cloneGame(&g)
+	return &c, nil
+}
+
+func (m *memory) UpdateGame(_ context.Context, g *models.Game) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stored, ok := m.games[g.ID]
+	if !ok {
+		return ErrNotFound
+	}
+	if stored.Version != g.Version {
+		return ErrConflict
+	}
+	g.Version++
+	m.games[g.ID] = cloneGame(g)
+	return nil
+}
+
+func (m *memory) CreateUser(_ context.Context, u *models.User) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, existing := range m.users {
+		if existing.Username == u.Username {
+			return ErrDuplicate
+		}
+	}
+	if u.ID == "" {
+		u.ID = primitive.NewObjectID().Hex()
+	}
+	now := time.Now().UTC()
+	u.CreatedAt, u.UpdatedAt = now, now
+
+	stored := *u
+	stored.Words = nil
+	m.users[u.ID] = stored
+	return nil
+}
+
+func (m *memory) GetUser(ctx context.Context, id string) (*models.User, error) {
+	m.mu.RLock()
+	u, ok := m.users[id]
+	m.mu.RUnlock()
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return m.withWords(ctx, u)
+}
+
+func (m *memory) LookupUser(_ context.Context, id string) (*models.User, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	u, ok := m.users[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &u, nil
+}
+
+func (m *memory) GetUserByUsername(ctx context.Context, username string) (*models.User, error) {
+	m.mu.RLock()
+	var found *models.User
+	for _, u := range m.users {
+		if u.Username == username {
+			u := u
+			found = &u
+			break
+		}
+	}
+	m.mu.RUnlock()
+	if found == nil {
+		return nil, ErrNotFound
+	}
+	return m.withWords(ctx, *found)
+}
+
+func (m *memory) withWords(ctx context.Context, u models.User) (*models.User, error) {
+	words, err := m.ListWordsByUser(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	u.Words = words
+	return &u, nil
+}
+
+func (m *memory) CreateWord(_ context.Context, w *models.Word) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if w.ID == "" {
+		w.ID = primitive.NewObjectID().Hex()
+	}
+	if _, ok := m.words[w.ID]; ok {
+		return ErrDuplicate
+	}
+	w.CreatedAt = time.Now().UTC()
+	m.words[w.ID] = *w
+	return nil
+}
+
+func (m *memory) GetWord(_ context.Context, id string) (*models.Word, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	w, ok := m.words[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &w, nil
+}
+
+func (m *memory) ListWordsByStatus(_ context.Context, status string) ([]models.Word, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	words := []models.Word{}
+	for _, w := range m.words {
+		if status == "" || w.Status == status {
+			words = append(words, w)
+		}
+	}
+	sort.Slice(words, func(i, j int) bool {
+		if !words[i].CreatedAt.Equal(words[j].CreatedAt) {
+			return words[i].CreatedAt.Before(words[j].CreatedAt)
+		}
+		return words[i].ID < words[j].ID
+	})
+	return words, nil
+}
+
+func (m *memory) ReviewWord(_ context.Context, id, status string) (*models.Word, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	w, ok := m.words[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	if w.Status != models.WordPending {
+		return nil, ErrConflict
+	}
+	now := time.Now().UTC()
+	w.Status = status
+	w.ReviewedAt = &now
+	m.words[id] = w
+	return &w, nil
+}
+
+func (m *memory) ListWordsByUser(_ context.Context, userID string) ([]models.Word, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	words := []models.Word{}
+	for _, w := range m.words {
+		if w.UserID == userID {
+			words = append(words, w)
+		}
+	}
+	sort.Slice(words, func(i, j int) bool {
+		return words[i].CreatedAt.After(words[j].CreatedAt)
+	})
+	return words, nil
+}
+
+func (m *memory) SetWordTier(_ context.Context, t *models.WordTier) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t.ID = t.Lang + ":" + t.Word
+	t.UpdatedAt = time.Now().UTC()
+	m.wordTiers[t.ID] = *t
+	return nil
+}
+
+func (m *memory) ListWordTiers(_ context.Context) ([]models.WordTier, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	tiers := make([]models.WordTier, 0, len(m.wordTiers))
+	for _, t := range m.wordTiers {
+		tiers = append(tiers, t)
+	}
+	sort.Slice(tiers, func(i, j int) bool {
+		return tiers[i].UpdatedAt.Before(tiers[j].UpdatedAt)
+	})
+	return tiers, nil
+}
+
+func (m *memory) GetStats(_ context.Context, userID string) (*models.Stats, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	st, ok := m.stats[userID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	st.Distribution = append([]int(nil), st.Distribution...)
+	return &st, nil
+}
+
+func (m *memory) SaveStats(_ context.Context, st *models.Stats) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.stats[st.UserID].Version != st.Version {
+		return ErrConflict
+	}
+	st.Version++
+	stored := *st
+	stored.Distribution = append([]int(nil), st.Distribution...)
+	m.stats[st.UserID] = stored
+	return nil
+}
+
+func (m *memory) CreateChallenge(_ context.Context, ch *models.Challenge) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.challenges[ch.ID]; ok {
+		return ErrDuplicate
+	}
+	ch.CreatedAt = time.Now().UTC()
+	m.challenges[ch.ID] = *ch
+	return nil
+}
+
+func (m *memory) GetChallenge(_ context.Context, id string) (*models.Challenge, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ch, ok := m.challenges[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &ch, nil
+}
+
+func (m *memory) ListChallengesByUser(_ context.Context, userID string) ([]models.Challenge, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	challenges := []models.Challenge{}
+	for _, ch := range m.challenges {
+		if ch.CreatorID == userID {
+			challenges = append(challenges, ch)
+		}
+	}
+	sort.Slice(challenges, func(i, j int) bool {
+		return challenges[i].CreatedAt.After(challenges[j].CreatedAt)
+	})
+	return challenges, nil
+}
+
+func (m *memory) IncrementChallenge(_ context.Context, id string, attempts, solves int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ch, ok := m.challenges[id]
+	if !ok {
+		return ErrNotFound
+	}
+	ch.Attempts += attempts
+	ch.Solves += solves
+	m.challenges[id] = ch
+	return nil
+}
+
+func (m *memory) DailyLeaderboard(_ context.Context, puzzle int, page Page) ([]models.LeaderboardEntry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	// Only a player's first game for the puzzle counts; games created at the
+	// same instant are told apart by ID, so the pick never depends on map order
+	first := make(map[string]models.Game)
+	for _, g := range m.games {
+		if g.PuzzleNumber != puzzle || g.UserID == "" || g.Seeded {
+			continue
+		}
+		prev, ok := first[g.UserID]
+		if !ok || g.CreatedAt.Before(prev.CreatedAt) || (g.CreatedAt.Equal(prev.CreatedAt) && g.ID < prev.ID) {
+			first[g.UserID] = g
+		}
+	}
+
+	entries := []models.LeaderboardEntry{}
+	for userID, g := range first {
+		if g.Status != "won" || g.FinishedAt == nil || g.Hinted {
+			continue
+		}
+		entries = append(entries, models.LeaderboardEntry{
+			UserID:     userID,
+			Username:   m.users[userID].Username,
+			Guesses:    len(g.Guesses),
+			SolveMs:    g.FinishedAt.Sub(g.CreatedAt).Milliseconds(),
+			FinishedAt: *g.FinishedAt,
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		switch {
+		case a.Guesses != b.Guesses:
+			return a.Guesses < b.Guesses
+		case a.SolveMs != b.SolveMs:
+			return a.SolveMs < b.SolveMs
+		case !a.FinishedAt.Equal(b.FinishedAt):
+			return a.FinishedAt.Before(b.FinishedAt)
+		default:
+			return a.UserID < b.UserID
+		}
+	})
+	return paginate(entries, page), nil
+}
+
+func (m *memory) DailyResults(_ context.Context, puzzle int) (*models.DailyResults, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	results := &models.DailyResults{PuzzleNumber: puzzle}
+	for _, g := range m.games {
+		if g.PuzzleNumber != puzzle || g.Seeded || g.Hinted || g.Status == "in_progress" {
+			continue
+		}
+		results.Played++
+		if g.Status == "won" {
+			results.Solved++
+		}
+	}
+	return results, nil
+}
+
+func (m *memory) WinRateLeaderboard(_ context.Context, minPlayed int, page Page) ([]models.LeaderboardEntry, error) {
+	entries := m.statsEntries(func(st models.Stats) bool { return st.Played >= max(minPlayed, 1) })
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		rateA := float64(a.Wins) / float64(a.Played)
+		rateB := float64(b.Wins) / float64(b.Played)
+		switch {
+		case rateA != rateB:
+			return rateA > rateB
+		case a.Played != b.Played:
+			return a.Played > b.Played
+		default:
+			return a.UserID < b.UserID
+		}
+	})
+	return paginate(entries, page), nil
+}
+
+func (m *memory) StreakLeaderboard(_ context.Context, page Page) ([]models.LeaderboardEntry, error) {
+	entries := m.statsEntries(func(st models.Stats) bool { return st.MaxStreak > 0 })
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.MaxStreak != b.MaxStreak {
+			return a.MaxStreak > b.MaxStreak
+		}
+		return a.UserID < b.UserID
+	})
+	return paginate(entries, page), nil
+}
+
+func (m *memory) statsEntries(keep func(models.Stats) bool) []models.LeaderboardEntry {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	entries := []models.LeaderboardEntry{}
+	for _, st := range m.stats {
+		if !keep(st) {
+			continue
+		}
+		entries = append(entries, models.LeaderboardEntry{
+			UserID:    st.UserID,
+			Username:  m.users[st.UserID].Username,
+			Played:    st.Played,
+			Wins:      st.Wins,
+			MaxStreak: st.MaxStreak,
+		})
+	}
+	return entries
+}
+
+func paginate(entries []models.LeaderboardEntry, page Page) []models.LeaderboardEntry {
+	if page.Offset >= len(entries) {
+		return []models.LeaderboardEntry{}
+	}
+	entries = entries[page.Offset:]
+	if page.Limit < len(entries) {
+		entries = entries[:page.Limit]
+	}
+	return entries
+}
+
+// cloneGame copies g down to its last slice: the game engine writes into
+// loaded games, such as marking boards in Solved, and must not reach the
+// stored copy through a shared backing array.
+func cloneGame(g *models.Game) models.Game {
+	c := *g
+	c.Targets = append([]string(nil), g.Targets...)
+	c.Solved = append([]int(nil), g.Solved...)
+	c.Candidates = append([]string(nil), g.Candidates...)
+	if g.FinishedAt != nil {
+		finished := *g.FinishedAt
+		c.FinishedAt = &finished
+	}
+
+	c.Guesses = nil
+	for _, guess := range g.Guesses {
+		guess.Feedback = append([]response.LetterFeedback(nil), guess.Feedback...)
+		boards := guess.Boards
+		guess.Boards = nil
+		for _, board := range boards {
+			guess.Boards = append(guess.Boards, append([]response.LetterFeedback(nil), board...))
+		}
+		c.Guesses = append(c.Guesses, guess)
+	}
+	return c
+}
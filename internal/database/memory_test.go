@@ -0,0 +1,314 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"Wordle/internal/models"
+)
+
+func TestMemoryGames(t *testing.T) {
+	ctx := context.Background()
+	db := NewMemory()
+
+	g := &models.Game{ID: "g1", Size: 5, MaxAttempts: 6, Target: "apple", Status: "in_progress"}
+	if err := db.CreateGame(ctx, g); err != nil {
+		t.Fatalf("CreateGame() returned error: %v", err)
+	}
+	if err := db.CreateGame(ctx, g); !errors.Is(err, ErrDuplicate) {
+		t.Errorf("CreateGame() twice error = %v; want %v", err, ErrDuplicate)
+	}
+
+	first, err := db.GetGame(ctx, "g1")
+	if err != nil {
+		t.Fatalf("GetGame() returned error: %v", err)
+	}
+	second, _ := db.GetGame(ctx, "g1")
+
+	first.Guesses = append(first.Guesses, models.Guess{Word: "crate"})
+
+	// Mutating a loaded game must not change the stored copy until UpdateGame
+	stored, _ := db.GetGame(ctx, "g1")
+	if len(stored.Guesses) != 0 {
+		t.Errorf("stored game has %d guesses before update; want 0", len(stored.Guesses))
+	}
+
+	if err := db.UpdateGame(ctx, first); err != nil {
+		t.Fatalf("UpdateGame() returned error: %v", err)
+	}
+	stored, _ = db.GetGame(ctx, "g1")
+	if len(stored.Guesses) != 1 {
+		t.Errorf("stored game has %d guesses after update; want 1", len(stored.Guesses))
+	}
+
+	// second was loaded before first was saved, so saving it would lose a guess
+	second.Guesses = append(second.Guesses, models.Guess{Word: "brick"})
+	if err := db.UpdateGame(ctx, second); !errors.Is(err, ErrConflict) {
+		t.Errorf("UpdateGame() with stale version error = %v; want %v", err, ErrConflict)
+	}
+
+	if _, err := db.GetGame(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetGame(missing) error = %v; want %v", err, ErrNotFound)
+	}
+}
+
+func TestMemoryGameSlices(t *testing.T) {
+	ctx := context.Background()
+	db := NewMemory()
+
+	g := &models.Game{
+		ID:      "g1",
+		Mode:    "dordle",
+		Targets: []string{"apple", "crane"},
+		Solved:  []int{0, 0},
+		Status:  "in_progress",
+	}
+	if err := db.CreateGame(ctx, g); err != nil {
+		t.Fatalf("CreateGame() returned error: %v", err)
+	}
+	g.Solved[0] = 9 // The caller's game is not the stored one
+
+	first, _ := db.GetGame(ctx, "g1")
+	second, _ := db.GetGame(ctx, "g1")
+	if err := db.UpdateGame(ctx, first); err != nil {
+		t.Fatalf("UpdateGame() returned error: %v", err)
+	}
+
+	// Solving a board of a stale game writes into its Solved slice, which
+	// must not show up in the store when the update is rejected
+	second.Solved[1] = 1
+	if err := db.UpdateGame(ctx, second); !errors.Is(err, ErrConflict) {
+		t.Fatalf("UpdateGame() with stale version error = %v; want %v", err, ErrConflict)
+	}
+
+	stored, _ := db.GetGame(ctx, "g1")
+	if !reflect.DeepEqual(stored.Solved, []int{0, 0}) {
+		t.Errorf("stored Solved = %v; want [0 0]", stored.Solved)
+	}
+}
+
+func TestMemoryUsers(t *testing.T) {
+	ctx := context.Background()
+	db := NewMemory()
+
+	u := &models.User{Username: "alice", Password: "hash"}
+	if err := db.CreateUser(ctx, u); err != nil {
+		t.Fatalf("CreateUser() returned error: %v", err)
+	}
+	if u.ID == "" {
+		t.Fatalf("CreateUser() did not assign an ID")
+	}
+	if err := db.CreateUser(ctx, &models.User{Username: "alice"}); !errors.Is(err, ErrDuplicate) {
+		t.Errorf("CreateUser() with taken username error = %v; want %v", err, ErrDuplicate)
+	}
+
+	if err := db.CreateWord(ctx, &models.Word{Content: "crane", UserID: u.ID}); err != nil {
+		t.Fatalf("CreateWord() returned error: %v", err)
+	}
+
+	byName, err := db.GetUserByUsername(ctx, "alice")
+	if err != nil {
+		t.Fatalf("GetUserByUsername() returned error: %v", err)
+	}
+	if byName.ID != u.ID {
+		t.Errorf("GetUserByUsername().ID = %q; want %q", byName.ID, u.ID)
+	}
+	if len(byName.Words) != 1 || byName.Words[0].Content != "crane" {
+		t.Errorf("GetUserByUsername().Words = %v; want [crane]", byName.Words)
+	}
+
+	if _, err := db.GetUser(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetUser(missing) error = %v; want %v", err, ErrNotFound)
+	}
+
+	// LookupUser skips the words
+	looked, err := db.LookupUser(ctx, u.ID)
+	if err != nil {
+		t.Fatalf("LookupUser() returned error: %v", err)
+	}
+	if looked.Username != "alice" || looked.Words != nil {
+		t.Errorf("LookupUser() = %q with words %v; want alice without words", looked.Username, looked.Words)
+	}
+	if _, err := db.LookupUser(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("LookupUser(missing) error = %v; want %v", err, ErrNotFound)
+	}
+}
+
+func TestMemoryWordReview(t *testing.T) {
+	ctx := context.Background()
+	db := NewMemory()
+
+	w := &models.Word{Lang: "en", Content: "quokka", Status: models.WordPending}
+	if err := db.CreateWord(ctx, w); err != nil {
+		t.Fatalf("CreateWord() returned error: %v", err)
+	}
+
+	pending, _ := db.ListWordsByStatus(ctx, models.WordPending)
+	if len(pending) != 1 {
+		t.Fatalf("ListWordsByStatus(pending) returned %d words; want 1", len(pending))
+	}
+
+	reviewed, err := db.ReviewWord(ctx, w.ID, models.WordApproved)
+	if err != nil {
+		t.Fatalf("ReviewWord() returned error: %v", err)
+	}
+	if reviewed.Status != models.WordApproved || reviewed.ReviewedAt == nil {
+		t.Errorf("ReviewWord() = %+v; want an approved word with a review time", reviewed)
+	}
+
+	if _, err := db.ReviewWord(ctx, w.ID, models.WordRejected); !errors.Is(err, ErrConflict) {
+		t.Errorf("ReviewWord() twice error = %v; want %v", err, ErrConflict)
+	}
+	if _, err := db.ReviewWord(ctx, "missing", models.WordApproved); !errors.Is(err, ErrNotFound) {
+		t.Errorf("ReviewWord(missing) error = %v; want %v", err, ErrNotFound)
+	}
+
+	pending, _ = db.ListWordsByStatus(ctx, models.WordPending)
+	all, _ := db.ListWordsByStatus(ctx, "")
+	if len(pending) != 0 || len(all) != 1 {
+		t.Errorf("after review: %d pending, %d total; want 0 and 1", len(pending), len(all))
+	}
+}
+
+func TestMemoryStats(t *testing.T) {
+	ctx := context.Background()
+	db := NewMemory()
+
+	if _, err := db.GetStats(ctx, "u1"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetStats() before save error = %v; want %v", err, ErrNotFound)
+	}
+
+	first := &models.Stats{UserID: "u1", Played: 1}
+	if err := db.SaveStats(ctx, first); err != nil {
+		t.Fatalf("SaveStats() returned error: %v", err)
+	}
+
+	// A second first save lost the race and must reload
+	if err := db.SaveStats(ctx, &models.Stats{UserID: "u1", Played: 1}); !errors.Is(err, ErrConflict) {
+		t.Errorf("SaveStats() with stale version error = %v; want %v", err, ErrConflict)
+	}
+
+	loaded, err := db.GetStats(ctx, "u1")
+	if err != nil {
+		t.Fatalf("GetStats() returned error: %v", err)
+	}
+	loaded.Played++
+	if err := db.SaveStats(ctx, loaded); err != nil {
+		t.Fatalf("SaveStats() after reload returned error: %v", err)
+	}
+	if stored, _ := db.GetStats(ctx, "u1"); stored.Played != 2 {
+		t.Errorf("stored Played = %d; want 2", stored.Played)
+	}
+}
+
+func TestMemoryLeaderboards(t *testing.T) {
+	ctx := context.Background()
+	db := NewMemory()
+
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	finished := func(id, userID string, guesses int, created time.Time, solve time.Duration, status string) *models.Game {
+		done := created.Add(solve)
+		g := &models.Game{
+			ID:           id,
+			UserID:       userID,
+			PuzzleNumber: 7,
+			Guesses:      make([]models.Guess, guesses),
+			Status:       status,
+			CreatedAt:    created,
+			FinishedAt:   &done,
+		}
+		if err := db.CreateGame(ctx, g); err != nil {
+			t.Fatalf("CreateGame(%s) returned error: %v", id, err)
+		}
+		return g
+	}
+
+	finished("a", "u1", 3, start, time.Minute, "won")
+	finished("b", "u2", 3, start, 30*time.Second, "won")
+	finished("c", "u3", 2, start, time.Hour, "won")
+	// u4 lost their first game, so the later win does not count
+	finished("d1", "u4", 6, start, time.Minute, "lost")
+	finished("d2", "u4", 1, start.Add(time.Hour), time.Second, "won")
+	// Ties on guesses and time fall back to the user ID
+	finished("e", "u0", 3, start, 30*time.Second, "won")
+	// Hinted games neither rank nor count towards the results
+	hinted := finished("f", "u5", 1, start, time.Second, "won")
+	hinted.Hinted = true
+	if err := db.UpdateGame(ctx, hinted); err != nil {
+		t.Fatalf("UpdateGame(f) returned error: %v", err)
+	}
+	// Of two games created at the same instant the lower ID is the first
+	finished("g2", "u6", 1, start, time.Second, "won")
+	finished("g1", "u6", 4, start, time.Second, "won")
+
+	entries, err := db.DailyLeaderboard(ctx, 7, Page{Limit: 10})
+	if err != nil {
+		t.Fatalf("DailyLeaderboard() returned error: %v", err)
+	}
+	var got []string
+	for _, e := range entries {
+		got = append(got, e.UserID)
+	}
+	if want := []string{"u3", "u0", "u2", "u1", "u6"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("DailyLeaderboard() order = %v; want %v", got, want)
+	}
+
+	// Walking the board one entry per page lists every player exactly once,
+	// however the ties were stored
+	for run := 0; run < 20; run++ {
+		var paged []string
+		for offset := 0; offset < len(got); offset++ {
+			page, _ := db.DailyLeaderboard(ctx, 7, Page{Offset: offset, Limit: 1})
+			for _, e := range page {
+				paged = append(paged, e.UserID)
+			}
+		}
+		if !reflect.DeepEqual(paged, got) {
+			t.Fatalf("DailyLeaderboard() pages = %v; want %v", paged, got)
+		}
+	}
+
+	results, err := db.DailyResults(ctx, 7)
+	if err != nil {
+		t.Fatalf("DailyResults() returned error: %v", err)
+	}
+	if results.Played != 8 || results.Solved != 7 {
+		t.Errorf("DailyResults() played/solved = %d/%d; want 8/7", results.Played, results.Solved)
+	}
+
+	page, _ := db.DailyLeaderboard(ctx, 7, Page{Offset: 4, Limit: 10})
+	if len(page) != 1 || page[0].UserID != "u6" {
+		t.Errorf("DailyLeaderboard() second page = %v; want [u6]", page)
+	}
+
+	for _, st := range []*models.Stats{
+		{UserID: "u1", Played: 4, Wins: 2, MaxStreak: 2},
+		{UserID: "u2", Played: 2, Wins: 1, MaxStreak: 5},
+		{UserID: "u3", Played: 3, Wins: 3, MaxStreak: 2},
+	} {
+		if err := db.SaveStats(ctx, st); err != nil {
+			t.Fatalf("SaveStats(%s) returned error: %v", st.UserID, err)
+		}
+	}
+
+	rates, _ := db.WinRateLeaderboard(ctx, 2, Page{Limit: 10})
+	got = nil
+	for _, e := range rates {
+		got = append(got, e.UserID)
+	}
+	if want := []string{"u3", "u1", "u2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("WinRateLeaderboard() order = %v; want %v", got, want)
+	}
+
+	streaks, _ := db.StreakLeaderboard(ctx, Page{Limit: 2})
+	got = nil
+	for _, e := range streaks {
+		got = append(got, e.UserID)
+	}
+	if want := []string{"u2", "u1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("StreakLeaderboard() order = %v; want %v", got, want)
+	}
+}
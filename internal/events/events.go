@@ -0,0 +1,225 @@
+// Package events fans server events out to Server-Sent Events subscribers.
+//
+// Every published event gets an increasing ID and is kept in a bounded
+// history, so a client that reconnects with the ID of the last event it saw
+// is replayed whatever it missed. Subscribers have bounded buffers: one that
+// falls behind is dropped rather than blocking publishers, and is expected to
+// reconnect with Last-Event-ID.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"sync"
+	"time"
+
+	"Wordle/internal/models"
+	"Wordle/internal/utils"
+)
+
+// Event types.
+const (
+	TypeRollover = "rollover" // A new daily puzzle started
+	TypeSolves   = "solves"   // Today's solve counters changed
+	TypeGame     = "game"     // A subscribed game received a guess
+)
+
+const (
+	// DefaultHistory is how many events are kept for Last-Event-ID replays
+	DefaultHistory = 256
+	// DefaultBuffer bounds how far a subscriber may fall behind before it is dropped
+	DefaultBuffer = 32
+	// HeartbeatInterval keeps idle connections and proxies from timing out
+	HeartbeatInterval = 15 * time.Second
+)
+
+// Event is one message on the feed. Game is empty for events sent to every
+// subscriber and names the game otherwise.
+type Event struct {
+	ID   uint64
+	Type string
+	Game string
+	Data []byte // JSON payload
+}
+
+// WriteTo writes e in the text/event-stream format. Events without an ID,
+// such as the snapshot sent on connect, do not move the client's
+// Last-Event-ID.
+func (e Event) WriteTo(w io.Writer) (int64, error) {
+	var n int
+	var err error
+	if e.ID != 0 {
+		n, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, e.Data)
+	} else {
+		n, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, e.Data)
+	}
+	return int64(n), err
+}
+
+// Rollover is the payload of a rollover event.
+type Rollover struct {
+	PuzzleNumber   int       `json:"puzzle_number"`
+	Date           string    `json:"date"`
+	NextRolloverAt time.Time `json:"next_rollover_at"`
+}
+
+// GameUpdate is the payload of a game event. It carries colours only, so a
+// game can be followed without seeing its letters.
+type GameUpdate struct {
+	GameID            string     `json:"game_id"`
+	Row               []string   `json:"row,omitempty"`
+	Boards            [][]string `json:"boards,omitempty"` // Multi-board games, null for boards solved earlier
+	Status            string     `json:"status"`
+	RemainingAttempts int        `json:"remaining_attempts"`
+}
+
+// Broker keeps the event history and the current subscribers.
+type Broker struct {
+	mu      sync.Mutex
+	nextID  uint64
+	history []Event
+	limit   int
+	buffer  int
+	subs    map[*Subscription]struct{}
+}
+
+// Subscription receives the events published after it was created.
+type Subscription struct {
+	broker *Broker
+	games  map[string]bool
+	events chan Event
+}
+
+// NewBroker creates a broker that keeps history events for replays and
+// buffers up to buffer events per subscriber.
+func NewBroker(history, buffer int) *Broker {
+	return &Broker{
+		// IDs start from the clock so they keep increasing across restarts
+		// and a stale Last-Event-ID never hides new events
+		nextID: uint64(time.Now().UnixMilli()),
+		limit:  history,
+		buffer: buffer,
+		subs:   map[*Subscription]struct{}{},
+	}
+}
+
+// Publish sends an event to every interested subscriber without waiting for
+// any of them. Subscribers whose buffer is full are dropped.
+func (b *Broker) Publish(typ, game string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.nextID++
+	e := Event{ID: b.nextID, Type: typ, Game: game, Data: data}
+	b.history = append(b.history, e)
+	if len(b.history) > b.limit {
+		b.history = b.history[len(b.history)-b.limit:]
+	}
+
+	for sub := range b.subs {
+		if !sub.wants(e) {
+			continue
+		}
+		select {
+		case sub.events <- e:
+		default:
+			b.drop(sub)
+		}
+	}
+	return nil
+}
+
+// Subscribe registers a subscriber for global events and the events of the
+// given games. When lastID is set, the retained events after it are replayed
+// first; a client that fell further behind than the history gets all of it.
+func (b *Broker) Subscribe(games []string, lastID uint64) (*Subscription, []Event) {
+	sub := &Subscription{
+		broker: b,
+		games:  map[string]bool{},
+		events: make(chan Event, b.buffer),
+	}
+	for _, id := range games {
+		sub.games[id] = true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var replay []Event
+	if lastID != 0 {
+		for _, e := range b.history {
+			if e.ID > lastID && sub.wants(e) {
+				replay = append(replay, e)
+			}
+		}
+	}
+	b.subs[sub] = struct{}{}
+	return sub, replay
+}
+
+// Subscribers returns the number of connected subscribers.
+func (b *Broker) Subscribers() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subs)
+}
+
+// drop removes a subscriber and closes its channel. b.mu must be held.
+func (b *Broker) drop(sub *Subscription) {
+	if _, ok := b.subs[sub]; ok {
+		delete(b.subs, sub)
+		close(sub.events)
+	}
+}
+
+// Events is closed when the subscriber is dropped or closed.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Close unsubscribes. It is safe to call more than once.
+func (s *Subscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	s.broker.drop(s)
+}
+
+func (s *Subscription) wants(e Event) bool {
+	return e.Game == "" || s.games[e.Game]
+}
+
+// WatchRollover publishes a rollover event, followed by fresh solve counters,
+// every time the daily puzzle changes. It returns when ctx is cancelled.
+func (b *Broker) WatchRollover(ctx context.Context, schedule *utils.DailySchedule) {
+	for {
+		next := schedule.NextRollover(time.Now())
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		number := schedule.PuzzleNumber(next)
+		err := b.Publish(TypeRollover, "", Rollover{
+			PuzzleNumber:   number,
+			Date:           schedule.Date(number).Format("2006-01-02"),
+			NextRolloverAt: schedule.NextRollover(next),
+		})
+		if err == nil {
+			err = b.Publish(TypeSolves, "", models.DailyResults{PuzzleNumber: number})
+		}
+		if err != nil {
+			log.Printf("failed to publish daily rollover: %v", err)
+		}
+	}
+}
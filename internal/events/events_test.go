@@ -0,0 +1,107 @@
+package events
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPublishFiltersByGame(t *testing.T) {
+	b := NewBroker(DefaultHistory, DefaultBuffer)
+	sub, _ := b.Subscribe([]string{"g1"}, 0)
+	defer sub.Close()
+
+	for _, game := range []string{"", "g1", "g2"} {
+		if err := b.Publish(TypeGame, game, GameUpdate{GameID: game}); err != nil {
+			t.Fatalf("Publish() returned error: %v", err)
+		}
+	}
+
+	var got []string
+	for len(sub.Events()) > 0 {
+		got = append(got, (<-sub.Events()).Game)
+	}
+	if strings.Join(got, ",") != ",g1" {
+		t.Errorf("subscriber received events for games %q; want global and g1 only", got)
+	}
+}
+
+func TestSubscribeReplay(t *testing.T) {
+	tests := []struct {
+		name    string
+		history int
+		after   int // Index of the last event the client saw, -1 for none
+		want    int
+	}{
+		{"New client gets no replay", 10, -1, 0},
+		{"Reconnect replays missed events", 10, 1, 3},
+		{"Up to date client gets nothing", 10, 4, 0},
+		{"History bounds the replay", 2, 0, 2},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBroker(tt.history, DefaultBuffer)
+			watcher, _ := b.Subscribe(nil, 0)
+			for i := 0; i < 5; i++ {
+				b.Publish(TypeSolves, "", i)
+			}
+
+			var ids []uint64
+			for len(watcher.Events()) > 0 {
+				ids = append(ids, (<-watcher.Events()).ID)
+			}
+			var lastID uint64
+			if tt.after >= 0 {
+				lastID = ids[tt.after]
+			}
+
+			_, replay := b.Subscribe(nil, lastID)
+			if len(replay) != tt.want {
+				t.Fatalf("Subscribe() replayed %d events; want %d", len(replay), tt.want)
+			}
+			for i, e := range replay {
+				if want := ids[len(ids)-tt.want+i]; e.ID != want {
+					t.Errorf("replay[%d].ID = %d; want %d", i, e.ID, want)
+				}
+			}
+		})
+	}
+}
+
+func TestSlowSubscriberIsDropped(t *testing.T) {
+	b := NewBroker(DefaultHistory, 2)
+	slow, _ := b.Subscribe(nil, 0)
+
+	for i := 0; i < 3; i++ {
+		if err := b.Publish(TypeSolves, "", i); err != nil {
+			t.Fatalf("Publish() returned error: %v", err)
+		}
+	}
+
+	if b.Subscribers() != 0 {
+		t.Errorf("Subscribers() = %d; want the slow subscriber dropped", b.Subscribers())
+	}
+	n := 0
+	for range slow.Events() {
+		n++
+	}
+	if n != 2 {
+		t.Errorf("slow subscriber received %d events before being dropped; want 2", n)
+	}
+	slow.Close() // Closing a dropped subscription is a no-op
+}
+
+func TestEventWriteTo(t *testing.T) {
+	var b strings.Builder
+	Event{ID: 7, Type: TypeRollover, Data: []byte(`{"puzzle_number":3}`)}.WriteTo(&b)
+	if want := "id: 7\nevent: rollover\ndata: {\"puzzle_number\":3}\n\n"; b.String() != want {
+		t.Errorf("WriteTo() = %q; want %q", b.String(), want)
+	}
+
+	b.Reset()
+	Event{Type: TypeSolves, Data: []byte(`{}`)}.WriteTo(&b)
+	if want := "event: solves\ndata: {}\n\n"; b.String() != want {
+		t.Errorf("WriteTo() without ID = %q; want %q", b.String(), want)
+	}
+}
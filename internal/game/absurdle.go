@@ -0,0 +1,74 @@
+package game
+
+import (
+	"errors"
+	"time"
+
+	"Wordle/internal/models"
+	"Wordle/internal/response"
+	"Wordle/internal/solver"
+	"Wordle/internal/utils"
+)
+
+// ModeAbsurdle never commits to a target up front. Every guess is answered
+// with the feedback that keeps the most candidate answers alive, so the game
+// only settles on a word once a single candidate remains.
+const ModeAbsurdle = "absurdle"
+
+var ErrAbsurdleUnsupported = errors.New("absurdle games cannot be daily or replayed")
+
+func newAbsurdle(opts Options) (*models.Game, error) {
+	if opts.Target != "" || opts.PuzzleNumber != 0 {
+		return nil, ErrAbsurdleUnsupported
+	}
+
+	ws, err := utils.Lookup(opts.Lang)
+	if err != nil {
+		return nil, err
+	}
+	candidates := ws.AnswersOfSize(opts.Size)
+	if len(candidates) == 0 {
+		return nil, utils.ErrNoWordsOfSize
+	}
+
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	return &models.Game{
+		ID:          id,
+		Mode:        ModeAbsurdle,
+		Lang:        ws.Language.Code,
+		Size:        opts.Size,
+		MaxAttempts: opts.MaxAttempts,
+		HardMode:    opts.HardMode,
+		Candidates:  candidates,
+		Guesses:     []models.Guess{},
+		Status:      StatusInProgress,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// scoreAbsurdle narrows the candidates to the largest bucket the guess splits
+// them into and returns that bucket's feedback. Ties go to the pattern that
+// reveals the least, which never favours the all-correct bucket while any
+// other bucket is as large. The target is fixed once one candidate is left.
+func scoreAbsurdle(g *models.Game, word string) []response.LetterFeedback {
+	best := -1
+	buckets := solver.Partition(word, g.Candidates)
+	for pattern, words := range buckets {
+		if best == -1 || len(words) > len(buckets[best]) ||
+			(len(words) == len(buckets[best]) && pattern < best) {
+			best = pattern
+		}
+	}
+
+	g.Candidates = buckets[best]
+	if len(g.Candidates) == 1 {
+		g.Target = g.Candidates[0]
+	}
+	return utils.CompareWords(word, g.Candidates[0])
+}
@@ -0,0 +1,210 @@
+package game
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"Wordle/internal/models"
+	"Wordle/internal/utils"
+)
+
+const (
+	StatusInProgress = "in_progress"
+	StatusWon        = "won"
+	StatusLost       = "lost"
+)
+
+const (
+	DefaultSize        = 5
+	DefaultMaxAttempts = 6
+)
+
+var (
+	ErrGameOver    = errors.New("game is already finished")
+	ErrWrongLength = errors.New("the length of guess does not match the game size")
+	ErrInvalidWord = errors.New("the guess is not a valid word")
+)
+
+// Options configures a new game. Zero values fall back to the defaults.
+type Options struct {
+	Mode        string // Defaults to ModeClassic
+	Lang        string
+	Size        int
+	Seed        int64 // Names the answer at that index in classic games; seeds the draw of multi-board games
+	MaxAttempts int
+	HardMode    bool
+
+	// Target fixes the word to guess instead of picking one, as daily games
+	// and replayed puzzle tokens do.
+	Target string
+	// PuzzleNumber marks the game as the given daily puzzle.
+	PuzzleNumber int
+}
+
+// New creates a game with a freshly picked target word, or with opts.Target
+// when one is given.
+func New(opts Options) (*models.Game, error) {
+	if opts.Mode == "" {
+		opts.Mode = ModeClassic
+	}
+	mode, ok := modes[opts.Mode]
+	if !ok {
+		return nil, ErrUnknownMode
+	}
+	if opts.Size == 0 {
+		opts.Size = DefaultSize
+	}
+	if opts.MaxAttempts == 0 {
+		opts.MaxAttempts = mode.MaxAttempts
+	}
+	if mode.Boards > 1 {
+		return newMulti(opts, mode)
+	}
+	if opts.Mode == ModeAbsurdle {
+		return newAbsurdle(opts)
+	}
+
+	ws, err := utils.Lookup(opts.Lang)
+	if err != nil {
+		return nil, err
+	}
+	target := ws.Normalize(opts.Target)
+	if target == "" {
+		// A seed names an answer; without one the target is drawn at random
+		if opts.Seed != 0 {
+			target, err = ws.AnswerAt(opts.Size, opts.Seed)
+		} else {
+			target, err = ws.RandomWord(opts.Size)
+		}
+		if err != nil {
+			return nil, err
+		}
+	} else if utils.WordLength(target) != opts.Size {
+		return nil, ErrWrongLength
+	}
+
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+
+	// Any target other than the daily one was picked by the client
+	seeded := opts.Seed != 0 || (opts.Target != "" && opts.PuzzleNumber == 0)
+
+	now := time.Now().UTC()
+	return &models.Game{
+		ID:           id,
+		Mode:         ModeClassic,
+		Lang:         ws.Language.Code,
+		Size:         opts.Size,
+		MaxAttempts:  opts.MaxAttempts,
+		Target:       target,
+		HardMode:     opts.HardMode,
+		PuzzleNumber: opts.PuzzleNumber,
+		Seeded:       seeded,
+		Guesses:      []models.Guess{},
+		Status:       StatusInProgress,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}, nil
+}
+
+// Play scores a guess against the game target, appends it to the history and
+// advances the game status.
+func Play(g *models.Game, word string) (*models.Guess, error) {
+	if g.Status != StatusInProgress {
+		return nil, ErrGameOver
+	}
+
+	ws, err := utils.Lookup(g.Lang)
+	if err != nil {
+		return nil, err
+	}
+
+	word = ws.Normalize(word)
+	if utils.WordLength(word) != g.Size {
+		return nil, ErrWrongLength
+	}
+	if IsMulti(g) {
+		return playMulti(g, ws, word)
+	}
+	// The target is always accepted so custom challenge words can be solved
+	if word != g.Target && !ws.IsValidWord(word) {
+		return nil, ErrInvalidWord
+	}
+	if g.HardMode {
+		if err := CheckHardMode(g.Guesses, word); err != nil {
+			return nil, err
+		}
+	}
+
+	guess := models.Guess{Word: word}
+	if g.Mode == ModeAbsurdle {
+		guess.Feedback = scoreAbsurdle(g, word)
+	} else {
+		guess.Feedback = utils.CompareWords(word, g.Target)
+	}
+	g.Guesses = append(g.Guesses, guess)
+	now := time.Now().UTC()
+	g.UpdatedAt = now
+
+	switch {
+	case word == g.Target:
+		g.Status = StatusWon
+	case len(g.Guesses) >= g.MaxAttempts:
+		g.Status = StatusLost
+		if g.Target == "" {
+			// An absurdle game that ran out of guesses reveals a word it could have been
+			g.Target = g.Candidates[0]
+		}
+	}
+	if Finished(g) {
+		g.FinishedAt = &now
+	}
+
+	return &guess, nil
+}
+
+// Remaining returns the number of guesses left in the game.
+func Remaining(g *models.Game) int {
+	if g.Status != StatusInProgress {
+		return 0
+	}
+	return g.MaxAttempts - len(g.Guesses)
+}
+
+// Finished reports whether the game has been won or lost.
+func Finished(g *models.Game) bool {
+	return g.Status != StatusInProgress
+}
+
+// RevealLetter returns the first position whose letter has not been placed
+// correctly by any guess yet, together with the target letter at it.
+func RevealLetter(g *models.Game) (int, string) {
+	targetLetters := utils.Letters(g.Target)
+	solved := make([]bool, len(targetLetters))
+	for _, guess := range g.Guesses {
+		for i, fb := range guess.Feedback {
+			if i < len(solved) && fb.Status == "correct" {
+				solved[i] = true
+			}
+		}
+	}
+
+	for i, done := range solved {
+		if !done {
+			return i, targetLetters[i]
+		}
+	}
+	return 0, targetLetters[0]
+}
+
+func newID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
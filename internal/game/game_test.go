@@ -0,0 +1,297 @@
+package game
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"Wordle/internal/response"
+	"Wordle/internal/solver"
+)
+
+func TestPlay(t *testing.T) {
+	tests := []struct {
+		name           string
+		guesses        []string
+		maxAttempts    int
+		expectedStatus string
+		expectedErr    error
+	}{
+		{
+			name:           "Win on first guess",
+			guesses:        []string{"brick"},
+			expectedStatus: StatusWon,
+		},
+		{
+			name:           "Win on last attempt",
+			guesses:        []string{"apple", "crate", "brick"},
+			maxAttempts:    3,
+			expectedStatus: StatusWon,
+		},
+		{
+			name:           "Lose after running out of attempts",
+			guesses:        []string{"apple", "crate"},
+			maxAttempts:    2,
+			expectedStatus: StatusLost,
+		},
+		{
+			name:           "Still in progress",
+			guesses:        []string{"apple"},
+			expectedStatus: StatusInProgress,
+		},
+		{
+			name:           "Guess after the game is over",
+			guesses:        []string{"brick", "apple"},
+			expectedStatus: StatusWon,
+			expectedErr:    ErrGameOver,
+		},
+		{
+			name:           "Wrong length",
+			guesses:        []string{"apples"},
+			expectedStatus: StatusInProgress,
+			expectedErr:    ErrWrongLength,
+		},
+		{
+			name:           "Unknown word",
+			guesses:        []string{"zzzzz"},
+			expectedStatus: StatusInProgress,
+			expectedErr:    ErrInvalidWord,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Seed 1 selects "brick" from the embedded word list
+			g, err := New(Options{Size: 5, Seed: 1, MaxAttempts: tt.maxAttempts})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			var lastErr error
+			for _, guess := range tt.guesses {
+				_, lastErr = Play(g, guess)
+			}
+
+			if !errors.Is(lastErr, tt.expectedErr) {
+				t.Errorf("Play() error = %v; want %v", lastErr, tt.expectedErr)
+			}
+			if g.Status != tt.expectedStatus {
+				t.Errorf("game status = %q; want %q", g.Status, tt.expectedStatus)
+			}
+		})
+	}
+}
+
+func TestRemaining(t *testing.T) {
+	g, err := New(Options{Size: 5, Seed: 1})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	if got := Remaining(g); got != DefaultMaxAttempts {
+		t.Errorf("Remaining() = %d; want %d", got, DefaultMaxAttempts)
+	}
+
+	if _, err := Play(g, "apple"); err != nil {
+		t.Fatalf("Play() returned error: %v", err)
+	}
+	if got := Remaining(g); got != DefaultMaxAttempts-1 {
+		t.Errorf("Remaining() = %d; want %d", got, DefaultMaxAttempts-1)
+	}
+
+	if _, err := Play(g, "brick"); err != nil {
+		t.Fatalf("Play() returned error: %v", err)
+	}
+	if got := Remaining(g); got != 0 {
+		t.Errorf("Remaining() after win = %d; want 0", got)
+	}
+}
+
+func TestNewWithTarget(t *testing.T) {
+	g, err := New(Options{Target: "Crate", PuzzleNumber: 42})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+	if g.Target != "crate" || g.PuzzleNumber != 42 {
+		t.Errorf("New() target, puzzle = %q, %d; want %q, 42", g.Target, g.PuzzleNumber, "crate")
+	}
+
+	if _, err := New(Options{Size: 6, Target: "crate"}); !errors.Is(err, ErrWrongLength) {
+		t.Errorf("New() with mismatched target error = %v; want %v", err, ErrWrongLength)
+	}
+}
+
+func TestMultiBoard(t *testing.T) {
+	g, err := New(Options{Mode: ModeQuordle, Seed: 3})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+	if len(g.Targets) != 4 || g.MaxAttempts != 9 || g.Target != "" {
+		t.Fatalf("New() targets, max attempts = %v, %d; want 4 targets and 9 attempts", g.Targets, g.MaxAttempts)
+	}
+	seen := map[string]bool{}
+	for _, target := range g.Targets {
+		if seen[target] {
+			t.Errorf("New() picked %q twice: %v", target, g.Targets)
+		}
+		seen[target] = true
+	}
+
+	for n, target := range g.Targets {
+		guess, err := Play(g, target)
+		if err != nil {
+			t.Fatalf("Play(%q) returned error: %v", target, err)
+		}
+		for i, fb := range guess.Boards {
+			if (fb == nil) != (i < n) {
+				t.Errorf("guess %d board %d feedback = %v; want feedback only for unsolved boards", n+1, i, fb)
+			}
+		}
+		if g.Solved[n] != n+1 {
+			t.Errorf("board %d solved in %d; want %d", n, g.Solved[n], n+1)
+		}
+	}
+	if g.Status != StatusWon {
+		t.Errorf("game status = %q; want %q", g.Status, StatusWon)
+	}
+	if rows := BoardFeedback(g, 1); len(rows) != 2 {
+		t.Errorf("BoardFeedback(board 1) has %d rows; want 2", len(rows))
+	}
+}
+
+func TestMultiBoardLost(t *testing.T) {
+	g, err := New(Options{Mode: ModeDordle, Seed: 3, MaxAttempts: 2})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+	if _, err := Play(g, g.Targets[0]); err != nil {
+		t.Fatalf("Play() returned error: %v", err)
+	}
+	if g.Status != StatusInProgress {
+		t.Errorf("game status after one board = %q; want %q", g.Status, StatusInProgress)
+	}
+	if _, err := Play(g, g.Targets[0]); err != nil {
+		t.Fatalf("Play() returned error: %v", err)
+	}
+	if g.Status != StatusLost {
+		t.Errorf("game status = %q; want %q", g.Status, StatusLost)
+	}
+}
+
+func TestNewMultiBoardOptions(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        Options
+		expectedErr error
+	}{
+		{"Unknown mode", Options{Mode: "hexadecordle"}, ErrUnknownMode},
+		{"Hard mode", Options{Mode: ModeDordle, HardMode: true}, ErrMultiUnsupported},
+		{"Daily puzzle", Options{Mode: ModeOctordle, Target: "crate", PuzzleNumber: 3}, ErrMultiUnsupported},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(tt.opts); !errors.Is(err, tt.expectedErr) {
+				t.Errorf("New() error = %v; want %v", err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestKeyboard(t *testing.T) {
+	rows := [][]response.LetterFeedback{
+		{{Letter: "a", Status: "correct"}, {Letter: "b", Status: "absent"}, {Letter: "c", Status: "present"}},
+		{{Letter: "a", Status: "present"}, {Letter: "c", Status: "correct"}, {Letter: "d", Status: "absent"}},
+	}
+	want := map[string]string{"a": "correct", "b": "absent", "c": "correct", "d": "absent"}
+
+	if got := Keyboard(rows); !reflect.DeepEqual(got, want) {
+		t.Errorf("Keyboard() = %v; want %v", got, want)
+	}
+}
+
+func TestAbsurdle(t *testing.T) {
+	g, err := New(Options{Mode: ModeAbsurdle, MaxAttempts: 1000})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+	if g.Target != "" || len(g.Candidates) == 0 {
+		t.Fatalf("New() target, candidates = %q, %d; want no target and every answer", g.Target, len(g.Candidates))
+	}
+
+	largest := 0
+	for _, n := range solver.Buckets("crate", g.Candidates) {
+		largest = max(largest, n)
+	}
+	if _, err := Play(g, "crate"); err != nil {
+		t.Fatalf("Play() returned error: %v", err)
+	}
+	if len(g.Candidates) != largest {
+		t.Errorf("Play() kept %d candidates; want the largest bucket of %d", len(g.Candidates), largest)
+	}
+
+	// Guessing a remaining candidate always removes it, so the game must settle
+	for g.Status == StatusInProgress {
+		before := len(g.Candidates)
+		if _, err := Play(g, g.Candidates[0]); err != nil {
+			t.Fatalf("Play() returned error: %v", err)
+		}
+		if g.Status == StatusInProgress && len(g.Candidates) >= before {
+			t.Fatalf("Play() kept %d of %d candidates", len(g.Candidates), before)
+		}
+		if got := solver.Candidates(g.Candidates, g.Guesses); len(got) != len(g.Candidates) {
+			t.Fatalf("%d of %d candidates contradict the feedback", len(g.Candidates)-len(got), len(g.Candidates))
+		}
+	}
+	if g.Status != StatusWon || g.Target != g.Guesses[len(g.Guesses)-1].Word {
+		t.Errorf("game status, target = %q, %q; want a win on the settled word", g.Status, g.Target)
+	}
+}
+
+func TestAbsurdleLost(t *testing.T) {
+	g, err := New(Options{Mode: ModeAbsurdle, MaxAttempts: 1})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+	if _, err := Play(g, "crate"); err != nil {
+		t.Fatalf("Play() returned error: %v", err)
+	}
+	if g.Status != StatusLost || g.Target == "" {
+		t.Errorf("game status, target = %q, %q; want a loss revealing a candidate", g.Status, g.Target)
+	}
+	if _, err := New(Options{Mode: ModeAbsurdle, Target: "crate", PuzzleNumber: 3}); !errors.Is(err, ErrAbsurdleUnsupported) {
+		t.Errorf("New() daily absurdle error = %v; want %v", err, ErrAbsurdleUnsupported)
+	}
+}
+
+func TestKeyboardState(t *testing.T) {
+	g, err := New(Options{Mode: ModeDordle, Seed: 3})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+	g.Targets = []string{"brick", "crate"}
+	if _, err := Play(g, "crate"); err != nil {
+		t.Fatalf("Play() returned error: %v", err)
+	}
+
+	keys := KeyboardState(g, []string{"a", "c", "r", "z"})
+	want := []response.KeyState{
+		{Letter: "a", Status: "correct", Boards: []string{"absent", "correct"}},
+		{Letter: "c", Status: "correct", Boards: []string{"present", "correct"}},
+		{Letter: "r", Status: "correct", Boards: []string{"correct", "correct"}},
+		{Letter: "z", Status: KeyUnused, Boards: []string{KeyUnused, KeyUnused}},
+	}
+	if !reflect.DeepEqual(keys, want) {
+		t.Errorf("KeyboardState() = %v; want %v", keys, want)
+	}
+
+	if _, err := Play(g, "brick"); err != nil {
+		t.Fatalf("Play() returned error: %v", err)
+	}
+	// Board 1 was solved by "crate" and never scores "brick"
+	if keys := KeyboardState(g, []string{"b"}); keys[0].Boards[1] != KeyUnused {
+		t.Errorf("KeyboardState() board 1 status of b = %q; want %q", keys[0].Boards[1], KeyUnused)
+	}
+}
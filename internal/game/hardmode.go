@@ -0,0 +1,101 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+
+	"Wordle/internal/models"
+	"Wordle/internal/utils"
+)
+
+// HardModeError lists every hint from earlier guesses that a new guess ignores.
+type HardModeError struct {
+	Violations []string
+}
+
+func (e *HardModeError) Error() string {
+	return "hard mode: " + strings.Join(e.Violations, "; ")
+}
+
+// CheckHardMode verifies that a guess reuses every hint revealed by the
+// history: letters marked correct must stay in place and present letters
+// must appear somewhere, as many times as they were revealed.
+func CheckHardMode(history []models.Guess, word string) error {
+	guessLetters := utils.Letters(word)
+
+	var violations []string
+	seen := map[string]bool{}
+	add := func(msg string) {
+		if !seen[msg] {
+			seen[msg] = true
+			violations = append(violations, msg)
+		}
+	}
+
+	for _, prior := range history {
+		required := map[string]int{}
+		hasPresent := map[string]bool{}
+		for i, fb := range prior.Feedback {
+			switch fb.Status {
+			case "correct":
+				required[fb.Letter]++
+				if i >= len(guessLetters) || guessLetters[i] != fb.Letter {
+					add(fmt.Sprintf("%s letter must be %s", ordinal(i+1), strings.ToUpper(fb.Letter)))
+				}
+			case "present":
+				required[fb.Letter]++
+				hasPresent[fb.Letter] = true
+			}
+		}
+
+		// Letters only ever marked correct are covered by the position checks
+		// above. Iterate in feedback order so messages are deterministic.
+		for _, fb := range prior.Feedback {
+			need, ok := required[fb.Letter]
+			if !ok || !hasPresent[fb.Letter] {
+				continue
+			}
+			delete(required, fb.Letter)
+			if countLetter(guessLetters, fb.Letter) < need {
+				if need == 1 {
+					add(fmt.Sprintf("Guess must contain %s", strings.ToUpper(fb.Letter)))
+				} else {
+					add(fmt.Sprintf("Guess must contain %s %d times", strings.ToUpper(fb.Letter), need))
+				}
+			}
+		}
+	}
+
+	if len(violations) > 0 {
+		return &HardModeError{Violations: violations}
+	}
+	return nil
+}
+
+func countLetter(letters []string, letter string) int {
+	n := 0
+	for _, l := range letters {
+		if l == letter {
+			n++
+		}
+	}
+	return n
+}
+
+// ordinal formats n as "1st", "2nd", "3rd", "4th" and so on.
+func ordinal(n int) string {
+	suffix := "th"
+	switch n % 100 {
+	case 11, 12, 13:
+	default:
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
@@ -0,0 +1,108 @@
+package game
+
+import (
+	"errors"
+	"testing"
+
+	"Wordle/internal/models"
+	"Wordle/internal/utils"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCheckHardMode(t *testing.T) {
+	tests := []struct {
+		name       string
+		target     string
+		history    []string
+		guess      string
+		violations []string
+	}{
+		{
+			name:    "No history",
+			target:  "brick",
+			history: nil,
+			guess:   "apple",
+		},
+		{
+			name:    "Hints reused",
+			target:  "brick",
+			history: []string{"crate"},
+			guess:   "urcxx",
+		},
+		{
+			name:       "Correct letter moved and present letter dropped",
+			target:     "brick",
+			history:    []string{"crate"},
+			guess:      "apple",
+			violations: []string{"2nd letter must be R", "Guess must contain C"},
+		},
+		{
+			name:       "Repeated letter revealed twice",
+			target:     "apple",
+			history:    []string{"paper"},
+			guess:      "xxpae",
+			violations: []string{"Guess must contain P 2 times"},
+		},
+		{
+			name:       "Hints accumulate across guesses",
+			target:     "brick",
+			history:    []string{"crate", "brink"},
+			guess:      "urcxx",
+			violations: []string{"1st letter must be B", "3rd letter must be I", "5th letter must be K"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var history []models.Guess
+			for _, word := range tt.history {
+				history = append(history, models.Guess{
+					Word:     word,
+					Feedback: utils.CompareWords(word, tt.target),
+				})
+			}
+
+			err := CheckHardMode(history, tt.guess)
+			if tt.violations == nil {
+				if err != nil {
+					t.Errorf("CheckHardMode(%q) returned error: %v", tt.guess, err)
+				}
+				return
+			}
+
+			var hardModeErr *HardModeError
+			if !errors.As(err, &hardModeErr) {
+				t.Fatalf("CheckHardMode(%q) error = %v; want *HardModeError", tt.guess, err)
+			}
+			if diff := cmp.Diff(tt.violations, hardModeErr.Violations); diff != "" {
+				t.Errorf("CheckHardMode(%q) violations mismatch (-want +got):\n%s", tt.guess, diff)
+			}
+		})
+	}
+}
+
+func TestPlayHardMode(t *testing.T) {
+	// Seed 1 selects "brick" from the embedded word list
+	g, err := New(Options{Size: 5, Seed: 1, HardMode: true})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	if _, err := Play(g, "crate"); err != nil {
+		t.Fatalf("Play(crate) returned error: %v", err)
+	}
+
+	var hardModeErr *HardModeError
+	if _, err := Play(g, "apple"); !errors.As(err, &hardModeErr) {
+		t.Errorf("Play(apple) error = %v; want *HardModeError", err)
+	}
+	if len(g.Guesses) != 1 {
+		t.Errorf("rejected guess was recorded: %d guesses; want 1", len(g.Guesses))
+	}
+
+	if _, err := Play(g, "brick"); err != nil {
+		t.Errorf("Play(brick) returned error: %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+package game
+
+import (
+	"Wordle/internal/models"
+	"Wordle/internal/response"
+)
+
+// statusRank orders letter statuses by how much they reveal; a key shows the
+// best status any guess earned for its letter.
+var statusRank = map[string]int{
+	"absent":  1,
+	"present": 2,
+	"correct": 3,
+}
+
+// Keyboard merges feedback rows into the best known status of every guessed
+// letter, with correct taking precedence over present and present over absent.
+func Keyboard(rows [][]response.LetterFeedback) map[string]string {
+	keys := map[string]string{}
+	for _, row := range rows {
+		for _, fb := range row {
+			if statusRank[fb.Status] > statusRank[keys[fb.Letter]] {
+				keys[fb.Letter] = fb.Status
+			}
+		}
+	}
+	return keys
+}
+
+// Colours strips the letters from a feedback row, leaving only its statuses.
+func Colours(feedback []response.LetterFeedback) []string {
+	row := make([]string, len(feedback))
+	for i, fb := range feedback {
+		row[i] = fb.Status
+	}
+	return row
+}
+
+// KeyUnused is the status of a key whose letter has not been guessed yet.
+const KeyUnused = "unused"
+
+// KeyboardState lays the best known status of every letter of alphabet out
+// in keyboard order. Keys of multi-board games also carry their status on
+// each board.
+func KeyboardState(g *models.Game, alphabet []string) []response.KeyState {
+	boards := 1
+	if IsMulti(g) {
+		boards = len(g.Targets)
+	}
+
+	var all [][]response.LetterFeedback
+	perBoard := make([]map[string]string, boards)
+	for i := range perBoard {
+		rows := BoardFeedback(g, i)
+		perBoard[i] = Keyboard(rows)
+		all = append(all, rows...)
+	}
+	overall := Keyboard(all)
+
+	keys := make([]response.KeyState, len(alphabet))
+	for i, letter := range alphabet {
+		keys[i] = response.KeyState{Letter: letter, Status: keyStatus(overall, letter)}
+		if IsMulti(g) {
+			keys[i].Boards = make([]string, boards)
+			for b, board := range perBoard {
+				keys[i].Boards[b] = keyStatus(board, letter)
+			}
+		}
+	}
+	return keys
+}
+
+func keyStatus(keys map[string]string, letter string) string {
+	if status, ok := keys[letter]; ok {
+		return status
+	}
+	return KeyUnused
+}
@@ -0,0 +1,146 @@
+package game
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"Wordle/internal/models"
+	"Wordle/internal/response"
+	"Wordle/internal/utils"
+)
+
+// Game modes. Multi-board modes score every guess against one target per
+// board and are won once every board is solved.
+const (
+	ModeClassic  = "classic"
+	ModeDordle   = "dordle"
+	ModeQuordle  = "quordle"
+	ModeOctordle = "octordle"
+)
+
+var (
+	ErrUnknownMode      = errors.New("unknown game mode")
+	ErrMultiUnsupported = errors.New("multi-board games cannot be daily, replayed or played in hard mode")
+)
+
+type modeRules struct {
+	Boards      int
+	MaxAttempts int // Default guess budget
+}
+
+var modes = map[string]modeRules{
+	ModeClassic:  {Boards: 1, MaxAttempts: DefaultMaxAttempts},
+	ModeDordle:   {Boards: 2, MaxAttempts: 7},
+	ModeQuordle:  {Boards: 4, MaxAttempts: 9},
+	ModeOctordle: {Boards: 8, MaxAttempts: 13},
+	ModeAbsurdle: {Boards: 1, MaxAttempts: 20},
+}
+
+// Boards returns the number of targets a mode plays at once, or 0 for an
+// unknown mode.
+func Boards(mode string) int {
+	return modes[mode].Boards
+}
+
+// IsMulti reports whether g is played on more than one board.
+func IsMulti(g *models.Game) bool {
+	return len(g.Targets) > 0
+}
+
+// Mode returns the mode of g, treating games stored before modes existed as classic.
+func Mode(g *models.Game) string {
+	if g.Mode == "" {
+		return ModeClassic
+	}
+	return g.Mode
+}
+
+func newMulti(opts Options, mode modeRules) (*models.Game, error) {
+	if opts.Target != "" || opts.PuzzleNumber != 0 || opts.HardMode {
+		return nil, ErrMultiUnsupported
+	}
+
+	ws, err := utils.Lookup(opts.Lang)
+	if err != nil {
+		return nil, err
+	}
+	targets, err := ws.RandomWords(opts.Size, mode.Boards, opts.Seed)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	return &models.Game{
+		ID:          id,
+		Mode:        opts.Mode,
+		Lang:        ws.Language.Code,
+		Size:        opts.Size,
+		MaxAttempts: opts.MaxAttempts,
+		Targets:     targets,
+		Solved:      make([]int, len(targets)),
+		Seeded:      opts.Seed != 0,
+		Guesses:     []models.Guess{},
+		Status:      StatusInProgress,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// playMulti scores an already normalised word against every board that is
+// still unsolved.
+func playMulti(g *models.Game, ws *utils.WordSet, word string) (*models.Guess, error) {
+	if !slices.Contains(g.Targets, word) && !ws.IsValidWord(word) {
+		return nil, ErrInvalidWord
+	}
+
+	guess := models.Guess{
+		Word:   word,
+		Boards: make([][]response.LetterFeedback, len(g.Targets)),
+	}
+	for i, target := range g.Targets {
+		if g.Solved[i] != 0 {
+			continue
+		}
+		guess.Boards[i] = utils.CompareWords(word, target)
+		if word == target {
+			g.Solved[i] = len(g.Guesses) + 1
+		}
+	}
+	g.Guesses = append(g.Guesses, guess)
+	now := time.Now().UTC()
+	g.UpdatedAt = now
+
+	switch {
+	case !slices.Contains(g.Solved, 0):
+		g.Status = StatusWon
+	case len(g.Guesses) >= g.MaxAttempts:
+		g.Status = StatusLost
+	}
+	if Finished(g) {
+		g.FinishedAt = &now
+	}
+
+	return &guess, nil
+}
+
+// BoardFeedback returns the feedback rows of one board, skipping the guesses
+// made after it was solved. Classic games have a single board 0.
+func BoardFeedback(g *models.Game, board int) [][]response.LetterFeedback {
+	rows := make([][]response.LetterFeedback, 0, len(g.Guesses))
+	for _, guess := range g.Guesses {
+		row := guess.Feedback
+		if IsMulti(g) {
+			row = guess.Boards[board]
+		}
+		if row != nil {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
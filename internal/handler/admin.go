@@ -0,0 +1,159 @@
+package handler
+
+import (
+	"Wordle/internal/database"
+	"Wordle/internal/models"
+	"Wordle/internal/response"
+	"Wordle/internal/utils"
+	"crypto/subtle"
+	"errors"
+	"net/url"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// AdminMiddleware only lets requests through that carry the admin token in
+// the X-Admin-Token header. With no token configured every request is refused.
+func AdminMiddleware(token string) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		if !isAdmin(c, token) {
+			return newError(fiber.StatusForbidden, response.CodeForbidden, "Admin access required")
+		}
+		return c.Next()
+	}
+}
+
+// SeedAccessMiddleware marks requests that may choose a target with a raw
+// seed: every request in debug mode, otherwise only admin requests. Everyone
+// else has to go through server-issued puzzle tokens.
+func SeedAccessMiddleware(debug bool, token string) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		c.Locals("seeds", debug || isAdmin(c, token))
+		return c.Next()
+	}
+}
+
+func isAdmin(c *fiber.Ctx, token string) bool {
+	given := c.Get("X-Admin-Token")
+	return token != "" && subtle.ConstantTimeCompare([]byte(given), []byte(token)) == 1
+}
+
+func GetWordTierHandler(c *fiber.Ctx) error {
+	ws, word, err := wordParam(c, c.Query("lang"))
+	if err != nil {
+		return err
+	}
+
+	tier := ws.Tier(word)
+	if tier == "" {
+		return utils.ErrWordNotFound
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.WordTier{
+		Lang: ws.Language.Code,
+		Word: word,
+		Tier: tier,
+	})
+}
+
+func SetWordTierHandler(db database.Service) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		var body response.BodyWordTierPut
+		if err := c.BodyParser(&body); err != nil {
+			return newError(fiber.StatusBadRequest, response.CodeInvalidJSON, "Invalid JSON")
+		}
+
+		if err := guessValidate.Struct(&body); err != nil {
+			return err
+		}
+
+		ws, word, err := wordParam(c, body.Lang)
+		if err != nil {
+			return err
+		}
+
+		if err := ws.SetTier(word, body.Tier); err != nil {
+			return err
+		}
+
+		if err := db.SetWordTier(c.UserContext(), &models.WordTier{
+			Lang: ws.Language.Code,
+			Word: word,
+			Tier: body.Tier,
+		}); err != nil {
+			return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to save word tier")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(response.WordTier{
+			Lang: ws.Language.Code,
+			Word: word,
+			Tier: ws.Tier(word),
+		})
+	}
+}
+
+// wordParam resolves the language and the normalised :word path parameter.
+func wordParam(c *fiber.Ctx, lang string) (*utils.WordSet, string, error) {
+	ws, err := utils.Lookup(lang)
+	if err != nil {
+		return nil, "", err
+	}
+
+	word, err := url.PathUnescape(c.Params("word"))
+	if err != nil {
+		return nil, "", newError(fiber.StatusBadRequest, response.CodeInvalidWord, "Invalid word")
+	}
+	return ws, ws.Normalize(word), nil
+}
+
+func ListSubmissionsHandler(db database.Service) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		status := c.Query("status", models.WordPending)
+		if status == "all" {
+			status = ""
+		}
+
+		words, err := db.ListWordsByStatus(c.UserContext(), status)
+		if err != nil {
+			return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to load submissions")
+		}
+
+		submissions := make([]response.WordSubmission, 0, len(words))
+		for i := range words {
+			submissions = append(submissions, wordSubmission(&words[i]))
+		}
+		return c.Status(fiber.StatusOK).JSON(submissions)
+	}
+}
+
+// ReviewSubmissionHandler approves or rejects a pending submission. Approved
+// words are merged into the live word list straight away.
+func ReviewSubmissionHandler(db database.Service, status string) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		w, err := db.ReviewWord(c.UserContext(), c.Params("id"), status)
+		switch {
+		case errors.Is(err, database.ErrNotFound):
+			return newError(fiber.StatusNotFound, response.CodeNotFound, "Submission not found")
+		case errors.Is(err, database.ErrConflict):
+			return newError(fiber.StatusConflict, response.CodeConflict, "Submission has already been reviewed")
+		case err != nil:
+			return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to review submission")
+		}
+
+		if status == models.WordApproved {
+			if ws, err := utils.Lookup(w.Lang); err == nil {
+				// The word may have been approved through another submission already
+				if err := ws.AddWord(w.Content); err != nil && !errors.Is(err, utils.ErrWordExists) {
+					return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to add the approved word")
+				}
+			}
+		}
+
+		return c.Status(fiber.StatusOK).JSON(wordSubmission(w))
+	}
+}
@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"Wordle/internal/auth"
+	"Wordle/internal/database"
+	"Wordle/internal/models"
+	"Wordle/internal/response"
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// AuthMiddleware attaches the user named by a "Bearer" token in the
+// Authorization header to the request. Requests without the header carry on
+// anonymously; requests with a bad token are rejected.
+func AuthMiddleware(db database.Service, tokens *auth.Tokens) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		header := c.Get(fiber.HeaderAuthorization)
+		if header == "" {
+			return c.Next()
+		}
+
+		token, found := strings.CutPrefix(header, "Bearer ")
+		if !found || tokens == nil {
+			return newError(fiber.StatusUnauthorized, response.CodeUnauthorized, "Authorization header must be a Bearer token")
+		}
+
+		userID, err := tokens.Verify(token)
+		if err != nil {
+			return newError(fiber.StatusUnauthorized, response.CodeUnauthorized, err.Error())
+		}
+
+		user, err := db.LookupUser(c.UserContext(), userID)
+		if errors.Is(err, database.ErrNotFound) {
+			return newError(fiber.StatusUnauthorized, response.CodeUnauthorized, "User no longer exists")
+		}
+		if err != nil {
+			return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to load user")
+		}
+
+		c.Locals("user", user)
+		return c.Next()
+	}
+}
+
+// RequireUser rejects anonymous requests. It must run after AuthMiddleware.
+func RequireUser(c *fiber.Ctx) error {
+	if currentUser(c) == nil {
+		return newError(fiber.StatusUnauthorized, response.CodeUnauthorized, "Authentication required")
+	}
+	return c.Next()
+}
+
+func RegisterHandler(db database.Service, tokens *auth.Tokens) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		body, err := parseAuthBody(c)
+		if err != nil {
+			return err
+		}
+
+		hash, err := auth.HashPassword(body.Password)
+		if errors.Is(err, auth.ErrPasswordTooLong) {
+			return err
+		}
+		if err != nil {
+			return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to hash password")
+		}
+
+		user := &models.User{
+			Username: strings.ToLower(body.Username),
+			Password: hash,
+		}
+		if err := db.CreateUser(c.UserContext(), user); err != nil {
+			if errors.Is(err, database.ErrDuplicate) {
+				return newError(fiber.StatusConflict, response.CodeConflict, "Username is already taken")
+			}
+			return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to create user")
+		}
+
+		return issueToken(c, tokens, user, fiber.StatusCreated)
+	}
+}
+
+func LoginHandler(db database.Service, tokens *auth.Tokens) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		body, err := parseAuthBody(c)
+		if err != nil {
+			return err
+		}
+
+		user, err := db.GetUserByUsername(c.UserContext(), strings.ToLower(body.Username))
+		if err != nil && !errors.Is(err, database.ErrNotFound) {
+			return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to load user")
+		}
+		if user == nil {
+			return auth.RejectPassword(body.Password)
+		}
+		if err := auth.CheckPassword(user.Password, body.Password); err != nil {
+			return err
+		}
+
+		return issueToken(c, tokens, user, fiber.StatusOK)
+	}
+}
+
+func MeHandler(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusOK).JSON(userProfile(currentUser(c)))
+}
+
+// parseAuthBody parses and validates a register or login request.
+func parseAuthBody(c *fiber.Ctx) (response.BodyAuthPost, error) {
+	var body response.BodyAuthPost
+	if err := c.BodyParser(&body); err != nil {
+		return body, newError(fiber.StatusBadRequest, response.CodeInvalidJSON, "Invalid JSON")
+	}
+	return body, guessValidate.Struct(&body)
+}
+
+func issueToken(c *fiber.Ctx, tokens *auth.Tokens, user *models.User, status int) error {
+	token, expires, err := tokens.Issue(user.ID)
+	if err != nil {
+		return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to issue token")
+	}
+
+	return c.Status(status).JSON(response.AuthToken{
+		Token:     token,
+		ExpiresAt: expires,
+		User:      userProfile(user),
+	})
+}
+
+func userProfile(user *models.User) response.UserProfile {
+	return response.UserProfile{
+		ID:        user.ID,
+		Username:  user.Username,
+		CreatedAt: user.CreatedAt,
+	}
+}
@@ -1,11 +1,42 @@
 package handler
 
 import (
+	"strconv"
+
+	"Wordle/internal/game"
+	"Wordle/internal/models"
 	"Wordle/internal/response"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
 )
 
+// currentUser returns the authenticated user attached to the request, if any.
+func currentUser(c *fiber.Ctx) *models.User {
+	user, _ := c.Locals("user").(*models.User)
+	return user
+}
+
+// seedsAllowed reports whether the request may pick a target with a raw seed.
+func seedsAllowed(c *fiber.Ctx) bool {
+	allowed, _ := c.Locals("seeds").(bool)
+	return allowed
+}
+
+// newValidator returns a validator that also knows the maxbytes tag, which
+// limits the UTF-8 length of a string where max only limits its characters.
+func newValidator() *validator.Validate {
+	v := validator.New()
+	v.RegisterValidation("maxbytes", func(fl validator.FieldLevel) bool {
+		n, err := strconv.Atoi(fl.Param())
+		return err == nil && len(fl.Field().String()) <= n
+	})
+	return v
+}
+
+var errSeedForbidden = newError(fiber.StatusForbidden, response.CodeSeedForbidden,
+	"The seed parameter is only honoured in debug mode or for admins; use a puzzle token instead")
+
 func parseValidationErrors(err error) []response.ValidationError {
 	var errors []response.ValidationError
 	if errs, ok := err.(validator.ValidationErrors); ok {
@@ -19,3 +50,15 @@ func parseValidationErrors(err error) []response.ValidationError {
 	}
 	return errors
 }
+
+func hardModeValidationErrors(err *game.HardModeError) []response.ValidationError {
+	var errors []response.ValidationError
+	for _, msg := range err.Violations {
+		errors = append(errors, response.ValidationError{
+			Loc:  []string{"guess"},
+			Msg:  msg,
+			Type: "hard_mode",
+		})
+	}
+	return errors
+}
@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"Wordle/internal/database"
+	"Wordle/internal/game"
+	"Wordle/internal/models"
+	"Wordle/internal/puzzle"
+	"Wordle/internal/response"
+	"Wordle/internal/session"
+	"Wordle/internal/utils"
+	"crypto/rand"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	// challengeCodeAlphabet is Crockford's base32, which avoids letters that
+	// are easily confused when a code is read out or typed
+	challengeCodeAlphabet = "0123456789abcdefghjkmnpqrstvwxyz"
+	challengeCodeLength   = 8
+)
+
+func CreateChallengeHandler(db database.Service, puzzles *puzzle.Codec) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		var body response.BodyChallengePost
+		if err := c.BodyParser(&body); err != nil {
+			return newError(fiber.StatusBadRequest, response.CodeInvalidJSON, "Invalid JSON")
+		}
+
+		if err := guessValidate.Struct(&body); err != nil {
+			return err
+		}
+
+		ws, err := utils.Lookup(body.Lang)
+		if err != nil {
+			return newError(fiber.StatusBadRequest, response.CodeUnsupportedLanguage, "Unsupported language")
+		}
+
+		word := ws.Normalize(body.Word)
+		size := utils.WordLength(word)
+		if size < 3 || size > 15 {
+			return newError(fiber.StatusBadRequest, response.CodeInvalidWord, "Challenge words must have between 3 and 15 letters")
+		}
+
+		custom := !ws.IsValidWord(word)
+		if custom && !body.Custom {
+			return newError(fiber.StatusBadRequest, response.CodeInvalidWord, "The word is not in the dictionary; set 'custom' to use it anyway")
+		}
+		if custom && !ws.Language.IsWord(word) {
+			return newError(fiber.StatusBadRequest, response.CodeInvalidWord, "The word contains letters outside the language's alphabet")
+		}
+
+		sealed, err := puzzles.Encode(puzzle.Ref{Lang: ws.Language.Code, Word: word})
+		if err != nil {
+			return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to seal the challenge word")
+		}
+
+		ch := &models.Challenge{
+			Lang:        ws.Language.Code,
+			Size:        size,
+			MaxAttempts: body.MaxAttempts,
+			Sealed:      sealed,
+			Custom:      custom,
+		}
+		if ch.MaxAttempts == 0 {
+			ch.MaxAttempts = game.DefaultMaxAttempts
+		}
+		if user := currentUser(c); user != nil {
+			ch.CreatorID = user.ID
+		}
+
+		// Codes are short enough that a collision is possible, if unlikely
+		for attempt := 0; attempt < 3; attempt++ {
+			if ch.ID, err = newChallengeCode(); err != nil {
+				break
+			}
+			if err = db.CreateChallenge(c.UserContext(), ch); !errors.Is(err, database.ErrDuplicate) {
+				break
+			}
+		}
+		if err != nil {
+			return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to create challenge")
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(challengeInfo(ch))
+	}
+}
+
+func GetChallengeHandler(db database.Service) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		ch, err := db.GetChallenge(c.UserContext(), c.Params("code"))
+		if err != nil {
+			return challengeLookupError(c, err)
+		}
+
+		return c.Status(fiber.StatusOK).JSON(challengeInfo(ch))
+	}
+}
+
+// ListMyChallengesHandler lists the signed-in player's challenges together
+// with how many people attempted and solved each one.
+func ListMyChallengesHandler(db database.Service) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		challenges, err := db.ListChallengesByUser(c.UserContext(), currentUser(c).ID)
+		if err != nil {
+			return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to list challenges")
+		}
+
+		infos := make([]response.ChallengeInfo, 0, len(challenges))
+		for i := range challenges {
+			infos = append(infos, challengeInfo(&challenges[i]))
+		}
+		return c.Status(fiber.StatusOK).JSON(infos)
+	}
+}
+
+// ChallengeGuessHandler scores a guess against a challenge. The first guess
+// starts a game for the player and returns its ID, which later guesses pass
+// back in game_id.
+func ChallengeGuessHandler(db database.Service, puzzles *puzzle.Codec, sessions *session.Service) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		var body response.BodyChallengeGuessPost
+		if err := c.BodyParser(&body); err != nil {
+			return newError(fiber.StatusBadRequest, response.CodeInvalidJSON, "Invalid JSON")
+		}
+
+		if err := guessValidate.Struct(&body); err != nil {
+			return err
+		}
+
+		ch, err := db.GetChallenge(c.UserContext(), c.Params("code"))
+		if err != nil {
+			return challengeLookupError(c, err)
+		}
+
+		if body.GameID != "" {
+			g, err := db.GetGame(c.UserContext(), body.GameID)
+			if err != nil {
+				return gameLookupError(err)
+			}
+			if g.ChallengeID != ch.ID {
+				return newError(fiber.StatusBadRequest, response.CodeBadRequest, "The game does not belong to this challenge")
+			}
+			if !canAccessGame(c, g) {
+				return session.ErrForbidden
+			}
+			return playGuess(c, sessions, g, body.Guess, false)
+		}
+
+		ref, err := puzzles.Decode(ch.Sealed)
+		if err != nil {
+			return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to open the challenge word")
+		}
+
+		g, err := game.New(game.Options{
+			Lang:        ref.Lang,
+			Size:        ch.Size,
+			MaxAttempts: ch.MaxAttempts,
+			Target:      ref.Word,
+		})
+		if err != nil {
+			return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to start the challenge game")
+		}
+		g.ChallengeID = ch.ID
+		if user := currentUser(c); user != nil {
+			g.UserID = user.ID
+		}
+
+		return playGuess(c, sessions, g, body.Guess, true)
+	}
+}
+
+func challengeLookupError(c *fiber.Ctx, err error) error {
+	if errors.Is(err, database.ErrNotFound) {
+		return newError(fiber.StatusNotFound, response.CodeNotFound, "Challenge not found")
+	}
+	return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to load challenge")
+}
+
+func challengeInfo(ch *models.Challenge) response.ChallengeInfo {
+	return response.ChallengeInfo{
+		Code:        ch.ID,
+		Lang:        ch.Lang,
+		Size:        ch.Size,
+		MaxAttempts: ch.MaxAttempts,
+		Custom:      ch.Custom,
+		Attempts:    ch.Attempts,
+		Solves:      ch.Solves,
+		CreatedAt:   ch.CreatedAt,
+	}
+}
+
+func newChallengeCode() (string, error) {
+	b := make([]byte, challengeCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	for i := range b {
+		b[i] = challengeCodeAlphabet[int(b[i])%len(challengeCodeAlphabet)]
+	}
+	return string(b), nil
+}
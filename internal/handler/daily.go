@@ -2,55 +2,48 @@ package handler
 
 import (
 	"Wordle/internal/response"
-	"Wordle/internal/utils"
-	"strings"
+	"Wordle/internal/session"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 )
 
-func DailyHandler(c *fiber.Ctx) error {
-	var query GuessQuery
+func DailyHandler(sessions *session.Service) func(*fiber.Ctx) error {
 
-	if err := c.QueryParser(&query); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid query parameters",
-		})
-	}
+	return func(c *fiber.Ctx) error {
+		var query GuessQuery
 
-	if query.Size == 0 {
-		query.Size = 5
-	}
+		if err := c.QueryParser(&query); err != nil {
+			return newError(fiber.StatusBadRequest, response.CodeInvalidQuery, "Invalid query parameters")
+		}
 
-	if err := guessValidate.Struct(&query); err != nil {
-		validationErrors := parseValidationErrors(err)
-		return c.Status(fiber.StatusUnprocessableEntity).JSON(response.HTTPValidationError{
-			Detail: validationErrors,
-		})
-	}
+		if err := guessValidate.Struct(&query); err != nil {
+			return err
+		}
 
-	if len(query.Guess) != query.Size {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "The length of guess does not match the specified size",
-		})
-	}
+		if query.Seed != 0 && !seedsAllowed(c) {
+			return errSeedForbidden
+		}
 
-	guessingWord := strings.ToLower(query.Guess)
-
-	if !utils.IsValidWord(guessingWord) {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "The guess is not a valid word",
+		feedback, _, err := sessions.Score(session.Score{
+			Lang:  query.Lang,
+			Guess: query.Guess,
+			Size:  query.Size,
+			Seed:  query.Seed,
+			Daily: true,
 		})
-	}
+		if err != nil {
+			return err
+		}
 
-	targetWord, err := utils.GetRandomWord(query.Size, query.Seed)
-	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "Failed to select a random word",
-		})
+		return c.Status(fiber.StatusOK).JSON(feedback)
 	}
+}
 
-	feedback := utils.CompareWords(guessingWord, targetWord)
-
-	return c.Status(fiber.StatusOK).JSON(feedback)
+// DailyInfoHandler describes the current daily puzzle without revealing its answer.
+func DailyInfoHandler(sessions *session.Service) func(*fiber.Ctx) error {
 
+	return func(c *fiber.Ctx) error {
+		return c.Status(fiber.StatusOK).JSON(sessions.DailyInfo(time.Now()))
+	}
 }
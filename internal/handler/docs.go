@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"Wordle/internal/openapi"
+	"Wordle/internal/response"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// OpenAPIHandler serves the API description.
+func OpenAPIHandler(doc *openapi.Document) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		return c.Status(fiber.StatusOK).JSON(doc)
+	}
+}
+
+// DocsHandler serves a page rendering the API description for people.
+func DocsHandler(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+	return c.Status(fiber.StatusOK).Send(openapi.DocsPage)
+}
+
+// DocsAssetHandler serves the scripts and styles of the docs page.
+func DocsAssetHandler(c *fiber.Ctx) error {
+	data, contentType, err := openapi.DocsAsset(c.Params("file"))
+	if err != nil {
+		return newError(fiber.StatusNotFound, response.CodeNotFound, "Not found")
+	}
+	c.Set(fiber.HeaderContentType, contentType)
+	c.Set(fiber.HeaderCacheControl, "public, max-age=86400")
+	return c.Status(fiber.StatusOK).Send(data)
+}
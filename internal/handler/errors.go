@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"Wordle/internal/auth"
+	"Wordle/internal/database"
+	"Wordle/internal/game"
+	"Wordle/internal/puzzle"
+	"Wordle/internal/race"
+	"Wordle/internal/response"
+	"Wordle/internal/session"
+	"Wordle/internal/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
+)
+
+// Error is a failed request. Handlers return it, or one of the domain errors
+// known to ErrorHandler, instead of writing error responses themselves.
+type Error struct {
+	Status  int
+	Code    string // One of response.ErrorCodes
+	Message string
+	Details []response.ValidationError
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func newError(status int, code, message string) *Error {
+	return &Error{Status: status, Code: code, Message: message}
+}
+
+// RequestID tags every request with an ID, taken from the X-Request-ID
+// header when the client sent one. Error responses repeat it so a report
+// can be matched to the server logs.
+func RequestID() func(*fiber.Ctx) error {
+	return requestid.New()
+}
+
+// ErrorHandler is the app's fiber.ErrorHandler. It writes every error a
+// handler returns as a response.Error.
+func ErrorHandler(c *fiber.Ctx, err error) error {
+	e := toError(err)
+	if e.Status >= fiber.StatusInternalServerError {
+		log.Printf("%s %s failed: %v", c.Method(), c.Path(), err)
+	}
+
+	id, _ := c.Locals("requestid").(string)
+	return c.Status(e.Status).JSON(response.Error{
+		Code:      e.Code,
+		Message:   e.Message,
+		Details:   e.Details,
+		RequestID: id,
+	})
+}
+
+// toError maps an error to its response. Domain errors get their own codes;
+// anything unknown is the server's fault and its message is not shown.
+func toError(err error) *Error {
+	var apiErr *Error
+	var fiberErr *fiber.Error
+	var validationErrs validator.ValidationErrors
+	var hardModeErr *game.HardModeError
+
+	switch {
+	case errors.As(err, &apiErr):
+		return apiErr
+	case errors.As(err, &fiberErr):
+		return newError(fiberErr.Code, statusCode(fiberErr.Code), fiberErr.Message)
+	case errors.As(err, &validationErrs):
+		return &Error{
+			Status:  fiber.StatusUnprocessableEntity,
+			Code:    response.CodeValidationFailed,
+			Message: "The request failed validation",
+			Details: parseValidationErrors(validationErrs),
+		}
+	case errors.As(err, &hardModeErr):
+		return &Error{
+			Status:  fiber.StatusUnprocessableEntity,
+			Code:    response.CodeHardModeViolation,
+			Message: "The guess does not use every revealed hint",
+			Details: hardModeValidationErrors(hardModeErr),
+		}
+
+	case errors.Is(err, utils.ErrUnknownLanguage):
+		return newError(fiber.StatusBadRequest, response.CodeUnsupportedLanguage, "Unsupported language")
+	case errors.Is(err, utils.ErrNoWordsOfSize), errors.Is(err, utils.ErrNotEnoughWords):
+		return newError(fiber.StatusBadRequest, response.CodeNoWordsOfSize, err.Error())
+	case errors.Is(err, utils.ErrEmptyWord), errors.Is(err, utils.ErrForeignLetters):
+		return newError(fiber.StatusBadRequest, response.CodeInvalidWord, err.Error())
+	case errors.Is(err, utils.ErrWordExists):
+		return newError(fiber.StatusConflict, response.CodeWordExists, err.Error())
+	case errors.Is(err, utils.ErrWordNotFound):
+		return newError(fiber.StatusNotFound, response.CodeNotFound, err.Error())
+	case errors.Is(err, game.ErrInvalidWord):
+		return newError(fiber.StatusBadRequest, response.CodeInvalidWord, "The guess is not a valid word")
+	case errors.Is(err, game.ErrWrongLength), errors.Is(err, session.ErrWrongLength):
+		return newError(fiber.StatusBadRequest, response.CodeWrongLength, "The length of guess does not match the specified size")
+	case errors.Is(err, game.ErrUnknownMode), errors.Is(err, game.ErrMultiUnsupported), errors.Is(err, game.ErrAbsurdleUnsupported),
+		errors.Is(err, utils.ErrUnknownTier):
+		return newError(fiber.StatusBadRequest, response.CodeBadRequest, err.Error())
+	case errors.Is(err, game.ErrGameOver):
+		return newError(fiber.StatusConflict, response.CodeGameOver, err.Error())
+	case errors.Is(err, puzzle.ErrInvalidToken):
+		return newError(fiber.StatusBadRequest, response.CodeInvalidPuzzleToken, err.Error())
+	case errors.Is(err, auth.ErrPasswordTooLong):
+		return &Error{
+			Status:  fiber.StatusUnprocessableEntity,
+			Code:    response.CodeValidationFailed,
+			Message: "The request failed validation",
+			Details: []response.ValidationError{{Loc: []string{"password"}, Msg: err.Error(), Type: "maxbytes"}},
+		}
+	case errors.Is(err, auth.ErrInvalidToken), errors.Is(err, auth.ErrExpiredToken), errors.Is(err, auth.ErrInvalidCredentials):
+		return newError(fiber.StatusUnauthorized, response.CodeUnauthorized, err.Error())
+	case errors.Is(err, session.ErrForbidden):
+		return newError(fiber.StatusForbidden, response.CodeForbidden, "Game belongs to another player")
+	case errors.Is(err, race.ErrRoomNotFound):
+		return newError(fiber.StatusNotFound, response.CodeNotFound, err.Error())
+	case errors.Is(err, database.ErrConflict):
+		return newError(fiber.StatusConflict, response.CodeConflict, "The resource was updated concurrently, please retry")
+	case errors.Is(err, database.ErrNotFound):
+		return newError(fiber.StatusNotFound, response.CodeNotFound, "Not found")
+
+	// Server-side failures of the session service say what failed, not why
+	case errors.Is(err, session.ErrSave):
+		return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to save game")
+	case errors.Is(err, session.ErrPuzzleToken):
+		return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to issue a puzzle token")
+	case errors.Is(err, session.ErrNoTarget):
+		return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to select a target word")
+	}
+	return newError(fiber.StatusInternalServerError, response.CodeInternal, "Internal server error")
+}
+
+// statusCode is the error code of errors that only carry an HTTP status,
+// such as Fiber's own 404 and 405.
+func statusCode(status int) string {
+	switch status {
+	case http.StatusUnauthorized:
+		return response.CodeUnauthorized
+	case http.StatusForbidden:
+		return response.CodeForbidden
+	case http.StatusNotFound:
+		return response.CodeNotFound
+	case http.StatusMethodNotAllowed:
+		return response.CodeMethodNotAllowed
+	case http.StatusConflict:
+		return response.CodeConflict
+	case http.StatusUnprocessableEntity:
+		return response.CodeValidationFailed
+	case http.StatusUpgradeRequired:
+		return response.CodeUpgradeRequired
+	}
+	if status >= http.StatusInternalServerError {
+		return response.CodeInternal
+	}
+	return response.CodeBadRequest
+}
@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"Wordle/internal/database"
+	"Wordle/internal/events"
+	"Wordle/internal/response"
+	"Wordle/internal/session"
+	"Wordle/internal/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// maxFollowedGames bounds the ?games= list of a single subscriber
+const maxFollowedGames = 20
+
+// EventsHandler streams the event feed as Server-Sent Events. Every
+// subscriber gets daily rollovers and today's solve counters; games listed in
+// ?games= (comma separated) add their guess events. A reconnecting client
+// resumes after the ID in its Last-Event-ID header, or in ?last_event_id= for
+// clients that cannot set headers. The stream starts with any replayed
+// events, then a snapshot of today's counters.
+func EventsHandler(db database.Service, schedule *utils.DailySchedule, feed *events.Broker) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		lastID, err := lastEventID(c)
+		if err != nil {
+			return newError(fiber.StatusBadRequest, response.CodeBadRequest, "Last-Event-ID must be a number")
+		}
+
+		var games []string
+		if list := c.Query("games"); list != "" {
+			games = strings.Split(list, ",")
+		}
+		if len(games) > maxFollowedGames {
+			return newError(fiber.StatusBadRequest, response.CodeBadRequest, fmt.Sprintf("At most %d games can be followed", maxFollowedGames))
+		}
+		for _, id := range games {
+			g, err := db.GetGame(c.UserContext(), id)
+			if err != nil {
+				return gameLookupError(err)
+			}
+			if !canAccessGame(c, g) {
+				return session.ErrForbidden
+			}
+		}
+
+		// Subscribe before loading the counters, so no solve can fall between
+		// the snapshot and the live events
+		sub, replay := feed.Subscribe(games, lastID)
+
+		results, err := db.DailyResults(c.UserContext(), schedule.PuzzleNumber(time.Now()))
+		if err != nil {
+			sub.Close()
+			return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to load today's results")
+		}
+		data, err := json.Marshal(results)
+		if err != nil {
+			sub.Close()
+			return err
+		}
+		snapshot := events.Event{Type: events.TypeSolves, Data: data}
+
+		c.Set(fiber.HeaderContentType, "text/event-stream")
+		c.Set(fiber.HeaderCacheControl, "no-cache")
+		c.Set(fiber.HeaderConnection, "keep-alive")
+		c.Set("X-Accel-Buffering", "no") // Keep reverse proxies from buffering the stream
+
+		c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+			defer sub.Close()
+
+			// Replayed counters are older than the snapshot, which comes last
+			for _, e := range replay {
+				if e.Type != events.TypeSolves {
+					e.WriteTo(w)
+				}
+			}
+			snapshot.WriteTo(w)
+			if err := w.Flush(); err != nil {
+				return
+			}
+
+			heartbeat := time.NewTicker(events.HeartbeatInterval)
+			defer heartbeat.Stop()
+			for {
+				select {
+				case e, ok := <-sub.Events():
+					if !ok {
+						// Dropped for falling behind; the client reconnects
+						// with Last-Event-ID and is replayed what it missed
+						return
+					}
+					e.WriteTo(w)
+				case <-heartbeat.C:
+					w.WriteString(": heartbeat\n\n")
+				}
+				// A failed flush means the client went away
+				if err := w.Flush(); err != nil {
+					return
+				}
+			}
+		})
+		return nil
+	}
+}
+
+func lastEventID(c *fiber.Ctx) (uint64, error) {
+	id := c.Get("Last-Event-ID", c.Query("last_event_id"))
+	if id == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(id, 10, 64)
+}
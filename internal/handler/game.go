@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"Wordle/internal/database"
+	"Wordle/internal/game"
+	"Wordle/internal/models"
+	"Wordle/internal/response"
+	"Wordle/internal/session"
+	"Wordle/internal/solver"
+	"Wordle/internal/utils"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func CreateGameHandler(sessions *session.Service) func(*fiber.Ctx) error {
+	return func(c *fiber.Ctx) error {
+		var body response.BodyGamePost
+		if len(c.Body()) > 0 {
+			if err := c.BodyParser(&body); err != nil {
+				return newError(fiber.StatusBadRequest, response.CodeInvalidJSON, "Invalid JSON")
+			}
+		}
+
+		if err := guessValidate.Struct(&body); err != nil {
+			return err
+		}
+
+		if body.Seed != 0 && !seedsAllowed(c) {
+			return errSeedForbidden
+		}
+
+		req := session.Start{
+			Options: game.Options{
+				Mode:        body.Mode,
+				Lang:        body.Lang,
+				Size:        body.Size,
+				Seed:        body.Seed,
+				MaxAttempts: body.MaxAttempts,
+				HardMode:    body.HardMode,
+			},
+			Daily:  body.Daily,
+			Puzzle: body.Puzzle,
+		}
+		if user := currentUser(c); user != nil {
+			req.UserID = user.ID
+		}
+
+		g, err := sessions.Start(c.UserContext(), req)
+		if errors.Is(err, session.ErrSave) {
+			return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to create game")
+		}
+		if err != nil {
+			return err
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(session.State(g))
+	}
+}
+
+func GetGameHandler(db database.Service) func(*fiber.Ctx) error {
+	return func(c *fiber.Ctx) error {
+		g, err := db.GetGame(c.UserContext(), c.Params("id"))
+		if err != nil {
+			return gameLookupError(err)
+		}
+		if !canAccessGame(c, g) {
+			return session.ErrForbidden
+		}
+
+		return c.Status(fiber.StatusOK).JSON(session.State(g))
+	}
+}
+
+func GameGuessHandler(db database.Service, sessions *session.Service) func(*fiber.Ctx) error {
+	return func(c *fiber.Ctx) error {
+		var body response.BodyGuessPost
+		if err := c.BodyParser(&body); err != nil {
+			return newError(fiber.StatusBadRequest, response.CodeInvalidJSON, "Invalid JSON")
+		}
+
+		if err := guessValidate.Struct(&body); err != nil {
+			return err
+		}
+
+		g, err := db.GetGame(c.UserContext(), c.Params("id"))
+		if err != nil {
+			return gameLookupError(err)
+		}
+		if !canAccessGame(c, g) {
+			return session.ErrForbidden
+		}
+
+		return playGuess(c, sessions, g, body.Guess, false)
+	}
+}
+
+// playGuess plays word in g through the session service and writes the
+// guess response.
+func playGuess(c *fiber.Ctx, sessions *session.Service, g *models.Game, word string, isNew bool) error {
+	guess, err := sessions.Guess(c.UserContext(), g, word, isNew)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(session.GuessResult(g, guess))
+}
+
+func GameKeyboardHandler(db database.Service) func(*fiber.Ctx) error {
+	return func(c *fiber.Ctx) error {
+		g, err := db.GetGame(c.UserContext(), c.Params("id"))
+		if err != nil {
+			return gameLookupError(err)
+		}
+		if !canAccessGame(c, g) {
+			return session.ErrForbidden
+		}
+
+		return c.Status(fiber.StatusOK).JSON(response.Keyboard{
+			GameID: g.ID,
+			Lang:   g.Lang,
+			Keys:   session.Keys(g),
+		})
+	}
+}
+
+func GameHintHandler(db database.Service) func(*fiber.Ctx) error {
+	return func(c *fiber.Ctx) error {
+		hintType := c.Query("type", "letter")
+		if hintType != "letter" && hintType != "candidates" {
+			return newError(fiber.StatusBadRequest, response.CodeBadRequest, "Query parameter 'type' must be 'letter' or 'candidates'")
+		}
+
+		g, err := db.GetGame(c.UserContext(), c.Params("id"))
+		if err != nil {
+			return gameLookupError(err)
+		}
+		if !canAccessGame(c, g) {
+			return session.ErrForbidden
+		}
+		if game.Finished(g) {
+			return game.ErrGameOver
+		}
+		if game.Mode(g) != game.ModeClassic {
+			return newError(fiber.StatusBadRequest, response.CodeBadRequest, "Hints are only available in classic games")
+		}
+
+		ws, err := utils.Lookup(g.Lang)
+		if err != nil {
+			return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to load the game's word list")
+		}
+
+		pool := ws.WordsOfSize(g.Size)
+		candidates := solver.Candidates(ws.AnswersOfSize(g.Size), g.Guesses)
+		hint := response.GameHint{
+			Type:      hintType,
+			Remaining: len(candidates),
+		}
+
+		switch hintType {
+		case "letter":
+			position, letter := game.RevealLetter(g)
+			hint.Position = position + 1
+			hint.Letter = letter
+		case "candidates":
+			if best := solver.Suggest(candidates, pool, solver.StrategyEntropy, 1); len(best) > 0 {
+				hint.Suggestion = best[0].Word
+			}
+		}
+
+		// A hinted game no longer counts towards stats and leaderboards
+		if !g.Hinted {
+			g.Hinted = true
+			if err := db.UpdateGame(c.UserContext(), g); err != nil {
+				return err
+			}
+		}
+
+		return c.Status(fiber.StatusOK).JSON(hint)
+	}
+}
+
+func gameLookupError(err error) error {
+	if errors.Is(err, database.ErrNotFound) {
+		return newError(fiber.StatusNotFound, response.CodeNotFound, "Game not found")
+	}
+	return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to load game")
+}
+
+// canAccessGame reports whether the current request may read or play g.
+func canAccessGame(c *fiber.Ctx, g *models.Game) bool {
+	var userID string
+	if user := currentUser(c); user != nil {
+		userID = user.ID
+	}
+	return session.CanAccess(g, userID)
+}
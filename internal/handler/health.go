@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"Wordle/internal/database"
+	"Wordle/internal/response"
+	"Wordle/internal/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// readyTimeout bounds the database ping of a readiness probe, so a hung
+// database fails the probe instead of outlasting the orchestrator's timeout.
+const readyTimeout = time.Second
+
+// HealthzHandler answers liveness probes. It only reports that the process
+// serves requests, so an outage of a dependency never gets it restarted.
+func HealthzHandler(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusOK).JSON(response.Health{
+		Status: response.HealthOK,
+		Build:  buildInfo(),
+	})
+}
+
+// ReadyzHandler answers readiness probes. It fails with 503 while the
+// database cannot be reached, the words stored in it are not loaded or a
+// language has no words to play, so traffic goes elsewhere until they
+// recover. loadWords loads the stored words; every probe retries it until it
+// succeeds. The probe is unauthenticated, so failures are only described in
+// the logs.
+func ReadyzHandler(db database.Service, loadWords func(context.Context) error) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		health := response.Health{
+			Status:       response.HealthOK,
+			Build:        buildInfo(),
+			Database:     databaseHealth(c.UserContext(), db),
+			StoredWords:  storedWordsHealth(c.UserContext(), loadWords),
+			Dictionaries: dictionaryHealth(),
+		}
+
+		ready := health.Database.Status == response.HealthOK && health.StoredWords.Status == response.HealthOK
+		for _, d := range health.Dictionaries {
+			ready = ready && d.Status == response.HealthOK
+		}
+		if !ready {
+			health.Status = response.HealthUnavailable
+			return c.Status(fiber.StatusServiceUnavailable).JSON(health)
+		}
+		return c.Status(fiber.StatusOK).JSON(health)
+	}
+}
+
+func databaseHealth(ctx context.Context, db database.Service) *response.DatabaseHealth {
+	ctx, cancel := context.WithTimeout(ctx, readyTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := db.Health(ctx)
+	health := &response.DatabaseHealth{
+		Status:    response.HealthOK,
+		LatencyMS: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		log.Printf("readiness probe: database unreachable: %v", err)
+		health.Status = response.HealthUnavailable
+		health.Error = "database unreachable"
+	}
+	return health
+}
+
+func storedWordsHealth(ctx context.Context, loadWords func(context.Context) error) *response.StoredWordsHealth {
+	ctx, cancel := context.WithTimeout(ctx, readyTimeout)
+	defer cancel()
+
+	if err := loadWords(ctx); err != nil {
+		log.Printf("readiness probe: stored words not loaded: %v", err)
+		return &response.StoredWordsHealth{Status: response.HealthUnavailable, Error: "stored words not loaded"}
+	}
+	return &response.StoredWordsHealth{Status: response.HealthOK}
+}
+
+func dictionaryHealth() []response.DictionaryHealth {
+	var dictionaries []response.DictionaryHealth
+	for _, lang := range utils.Languages() {
+		ws, _ := utils.Lookup(lang)
+		d := response.DictionaryHealth{
+			Status:  response.HealthOK,
+			Lang:    lang,
+			Words:   ws.Words.Sizes(),
+			Answers: ws.Answers.Sizes(),
+		}
+		if ws.Words.Len() == 0 || ws.Answers.Len() == 0 {
+			d.Status = response.HealthUnavailable
+		}
+		dictionaries = append(dictionaries, d)
+	}
+	return dictionaries
+}
+
+// buildInfo reads the module version and VCS stamp the Go toolchain embeds
+// in the binary.
+var buildInfo = sync.OnceValue(func() response.BuildInfo {
+	info := response.BuildInfo{Version: "(devel)"}
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+
+	info.GoVersion = bi.GoVersion
+	if bi.Main.Version != "" {
+		info.Version = bi.Main.Version
+	}
+	for _, s := range bi.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			info.Revision = s.Value
+		case "vcs.time":
+			info.Time = s.Value
+		case "vcs.modified":
+			info.Modified = s.Value == "true"
+		}
+	}
+	return info
+})
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"Wordle/internal/database"
+	"Wordle/internal/models"
+	"Wordle/internal/response"
+	"Wordle/internal/stats"
+	"context"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	defaultPerPage = 20
+	// defaultMinPlayed keeps a single lucky win from topping the win-rate board
+	defaultMinPlayed = 10
+)
+
+type LeaderboardQuery struct {
+	Page      int `query:"page" validate:"omitempty,min=1,max=10000"` // Bounded so the offset cannot overflow
+	PerPage   int `query:"per_page" validate:"omitempty,min=1,max=100"`
+	MinPlayed int `query:"min_played" validate:"omitempty,min=1"`
+}
+
+func DailyLeaderboardHandler(db database.Service) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		number, err := strconv.Atoi(c.Params("number"))
+		if err != nil || number < 1 {
+			return newError(fiber.StatusBadRequest, response.CodeBadRequest, "Puzzle number must be a positive integer")
+		}
+
+		return leaderboard(c, response.Leaderboard{Board: "daily", PuzzleNumber: number}, func(ctx context.Context, query LeaderboardQuery, page database.Page) ([]models.LeaderboardEntry, error) {
+			return db.DailyLeaderboard(ctx, number, page)
+		})
+	}
+}
+
+func WinRateLeaderboardHandler(db database.Service) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		return leaderboard(c, response.Leaderboard{Board: "win_rate"}, func(ctx context.Context, query LeaderboardQuery, page database.Page) ([]models.LeaderboardEntry, error) {
+			if query.MinPlayed == 0 {
+				query.MinPlayed = defaultMinPlayed
+			}
+			return db.WinRateLeaderboard(ctx, query.MinPlayed, page)
+		})
+	}
+}
+
+func StreakLeaderboardHandler(db database.Service) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		return leaderboard(c, response.Leaderboard{Board: "streak"}, func(ctx context.Context, _ LeaderboardQuery, page database.Page) ([]models.LeaderboardEntry, error) {
+			return db.StreakLeaderboard(ctx, page)
+		})
+	}
+}
+
+type leaderboardLoader func(context.Context, LeaderboardQuery, database.Page) ([]models.LeaderboardEntry, error)
+
+// leaderboard parses the paging parameters shared by every board, runs load
+// and ranks the resulting page into resp.
+func leaderboard(c *fiber.Ctx, resp response.Leaderboard, load leaderboardLoader) error {
+	var query LeaderboardQuery
+	if err := c.QueryParser(&query); err != nil {
+		return newError(fiber.StatusBadRequest, response.CodeInvalidQuery, "Invalid query parameters")
+	}
+
+	if err := guessValidate.Struct(&query); err != nil {
+		return err
+	}
+
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.PerPage == 0 {
+		query.PerPage = defaultPerPage
+	}
+	page := database.Page{
+		Offset: (query.Page - 1) * query.PerPage,
+		Limit:  query.PerPage,
+	}
+
+	entries, err := load(c.UserContext(), query, page)
+	if err != nil {
+		return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to load leaderboard")
+	}
+
+	resp.Page = query.Page
+	resp.PerPage = query.PerPage
+	resp.Entries = make([]response.LeaderboardEntry, 0, len(entries))
+	for i, e := range entries {
+		resp.Entries = append(resp.Entries, response.LeaderboardEntry{
+			Rank:          page.Offset + i + 1,
+			UserID:        e.UserID,
+			Username:      e.Username,
+			Guesses:       e.Guesses,
+			SolveSeconds:  float64(e.SolveMs) / 1000,
+			GamesPlayed:   e.Played,
+			Wins:          e.Wins,
+			WinPercentage: stats.WinPercentage(&models.Stats{Played: e.Played, Wins: e.Wins}),
+			MaxStreak:     e.MaxStreak,
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
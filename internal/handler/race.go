@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"Wordle/internal/race"
+	"Wordle/internal/response"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	// racePongWait is how long a race connection may stay silent, pings
+	// included, before it is treated as gone
+	racePongWait   = 60 * time.Second
+	racePingPeriod = racePongWait * 9 / 10
+	raceWriteWait  = 10 * time.Second
+)
+
+// raceMessage is what players send over the race socket.
+type raceMessage struct {
+	Type  string `json:"type"` // Values: "start", "guess"
+	Guess string `json:"guess"`
+}
+
+func CreateRaceHandler(lobby *race.Lobby) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		var body response.BodyRacePost
+		if len(c.Body()) > 0 {
+			if err := c.BodyParser(&body); err != nil {
+				return newError(fiber.StatusBadRequest, response.CodeInvalidJSON, "Invalid JSON")
+			}
+		}
+
+		if err := guessValidate.Struct(&body); err != nil {
+			return err
+		}
+
+		room, err := lobby.Create(race.Options{
+			Lang:        body.Lang,
+			Size:        body.Size,
+			MaxAttempts: body.MaxAttempts,
+			MaxPlayers:  body.MaxPlayers,
+			TimeLimit:   time.Duration(body.TimeLimitSeconds) * time.Second,
+		})
+		if err != nil {
+			return err
+		}
+
+		state := room.State(nil)
+		return c.Status(fiber.StatusCreated).JSON(response.RaceRoom{
+			Code:        room.Code,
+			Lang:        state.Lang,
+			Size:        state.Size,
+			MaxAttempts: state.MaxAttempts,
+			Socket:      "/race/rooms/" + room.Code + "/ws",
+		})
+	}
+}
+
+// RaceRoomMiddleware resolves the room of a race socket before the upgrade,
+// so unknown rooms and plain HTTP requests get ordinary error responses.
+func RaceRoomMiddleware(lobby *race.Lobby) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		room, err := lobby.Room(c.Params("code"))
+		if err != nil {
+			return err
+		}
+		if !websocket.IsWebSocketUpgrade(c) {
+			return newError(fiber.StatusUpgradeRequired, response.CodeUpgradeRequired, "This endpoint only accepts WebSocket connections")
+		}
+
+		c.Locals("room", room)
+		return c.Next()
+	}
+}
+
+// RaceSocketHandler connects a player to a race room. New players pass
+// ?name=; players reconnecting after a dropped connection pass the
+// ?player= ID they were sent in their first "state" event instead.
+func RaceSocketHandler() fiber.Handler {
+
+	return websocket.New(func(conn *websocket.Conn) {
+		room := conn.Locals("room").(*race.Room)
+
+		var writeMu sync.Mutex
+		write := func(e race.Event) error {
+			writeMu.Lock()
+			defer writeMu.Unlock()
+			conn.SetWriteDeadline(time.Now().Add(raceWriteWait))
+			return conn.WriteJSON(e)
+		}
+
+		var player *race.Player
+		var err error
+		if id := conn.Query("player"); id != "" {
+			player, err = room.Rejoin(id)
+		} else {
+			player, err = room.Join(conn.Query("name"))
+		}
+		if err != nil {
+			write(race.Event{Type: "error", Error: err.Error()})
+			return
+		}
+		events := player.Events()
+
+		// Pump room events to the socket; the room closes events when this
+		// connection should end, and closing the socket stops the reader
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			defer conn.Close()
+			ping := time.NewTicker(racePingPeriod)
+			defer ping.Stop()
+			for {
+				select {
+				case e, ok := <-events:
+					if !ok {
+						return
+					}
+					if err := write(e); err != nil {
+						return
+					}
+				case <-ping.C:
+					writeMu.Lock()
+					err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(raceWriteWait))
+					writeMu.Unlock()
+					if err != nil {
+						return
+					}
+				}
+			}
+		}()
+
+		conn.SetReadDeadline(time.Now().Add(racePongWait))
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(racePongWait))
+		})
+		for {
+			var msg raceMessage
+			if err := conn.ReadJSON(&msg); err != nil {
+				break
+			}
+			conn.SetReadDeadline(time.Now().Add(racePongWait))
+
+			switch msg.Type {
+			case "start":
+				err = room.Start(player)
+			case "guess":
+				err = room.Guess(player, msg.Guess)
+			default:
+				err = errors.New("unknown message type")
+			}
+			if err != nil {
+				write(race.Event{Type: "error", Error: err.Error()})
+			}
+		}
+
+		// Disconnecting closes events, which ends the writer
+		room.Disconnect(player, events)
+		<-done
+	})
+}
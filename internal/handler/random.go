@@ -2,64 +2,51 @@ package handler
 
 import (
 	"Wordle/internal/response"
+	"Wordle/internal/session"
 
-	"Wordle/internal/utils"
-	"strings"
-
-	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
 )
 
 type GuessQuery struct {
+	Lang  string `query:"lang" validate:"omitempty"`
 	Guess string `query:"guess" validate:"required"`
 	Size  int    `query:"size" validate:"omitempty,min=3,max=15"`
-	Seed  int64  `query:"seed" validate:"omitempty"`
+	Seed  int64  `query:"seed" validate:"omitempty"` // Debug and admin only
+	// Puzzle replays the target of an earlier response's X-Puzzle-Token header
+	Puzzle string `query:"puzzle" validate:"omitempty,excluded_with=Seed"`
 }
 
-var guessValidate = validator.New()
+var guessValidate = newValidator()
 
-func RandomHandler(c *fiber.Ctx) error {
-	var query GuessQuery
+func RandomHandler(sessions *session.Service) func(*fiber.Ctx) error {
 
-	if err := c.QueryParser(&query); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid query parameters",
-		})
-	}
+	return func(c *fiber.Ctx) error {
+		var query GuessQuery
 
-	if query.Size == 0 {
-		query.Size = 5
-	}
+		if err := c.QueryParser(&query); err != nil {
+			return newError(fiber.StatusBadRequest, response.CodeInvalidQuery, "Invalid query parameters")
+		}
 
-	if err := guessValidate.Struct(&query); err != nil {
-		validationErrors := parseValidationErrors(err)
-		return c.Status(fiber.StatusUnprocessableEntity).JSON(response.HTTPValidationError{
-			Detail: validationErrors,
-		})
-	}
+		if err := guessValidate.Struct(&query); err != nil {
+			return err
+		}
 
-	if len(query.Guess) != query.Size {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "The length of guess does not match the specified size",
-		})
-	}
-
-	guessingWord := strings.ToLower(query.Guess)
+		if query.Seed != 0 && !seedsAllowed(c) {
+			return errSeedForbidden
+		}
 
-	if !utils.IsValidWord(guessingWord) {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "The guess is not a valid word",
+		feedback, token, err := sessions.Score(session.Score{
+			Lang:   query.Lang,
+			Guess:  query.Guess,
+			Size:   query.Size,
+			Seed:   query.Seed,
+			Puzzle: query.Puzzle,
 		})
-	}
+		if err != nil {
+			return err
+		}
+		c.Set("X-Puzzle-Token", token)
 
-	targetWord, err := utils.GetRandomWord(query.Size, query.Seed)
-	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "Failed to select a random word",
-		})
+		return c.Status(fiber.StatusOK).JSON(feedback)
 	}
-	feedback := utils.CompareWords(guessingWord, targetWord)
-
-	return c.Status(fiber.StatusOK).JSON(feedback)
-
 }
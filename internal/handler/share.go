@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"Wordle/internal/database"
+	"Wordle/internal/game"
+	"Wordle/internal/response"
+	"Wordle/internal/session"
+	"Wordle/internal/share"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func GameShareHandler(db database.Service) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		g, err := db.GetGame(c.UserContext(), c.Params("id"))
+		if err != nil {
+			return gameLookupError(err)
+		}
+		if !canAccessGame(c, g) {
+			return session.ErrForbidden
+		}
+		if !game.Finished(g) {
+			return newError(fiber.StatusConflict, response.CodeConflict, "Only finished games can be shared")
+		}
+		if game.IsMulti(g) {
+			return newError(fiber.StatusBadRequest, response.CodeBadRequest, "Only classic games can be shared")
+		}
+
+		style := c.Query("style", share.StyleStandard)
+		text, err := share.Render(share.FromGame(g), style)
+		if errors.Is(err, share.ErrUnknownStyle) {
+			return newError(fiber.StatusBadRequest, response.CodeBadRequest, "Query parameter 'style' must be 'standard', 'high_contrast' or 'plain'")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(response.ShareText{
+			Style: style,
+			Text:  text,
+		})
+	}
+}
+
+func ShareParseHandler(db database.Service) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		var body response.BodySharePost
+		if err := c.BodyParser(&body); err != nil {
+			return newError(fiber.StatusBadRequest, response.CodeInvalidJSON, "Invalid JSON")
+		}
+
+		if err := guessValidate.Struct(&body); err != nil {
+			return err
+		}
+
+		r, err := share.Parse(body.Text)
+		if err != nil {
+			return newError(fiber.StatusBadRequest, response.CodeBadRequest, err.Error())
+		}
+
+		result := response.SharedResult{
+			Title:        r.Title,
+			PuzzleNumber: r.PuzzleNumber,
+			MaxAttempts:  r.MaxAttempts,
+			Won:          r.Won,
+			HardMode:     r.HardMode,
+			Rows:         r.Rows,
+		}
+
+		if body.GameID != "" {
+			g, err := db.GetGame(c.UserContext(), body.GameID)
+			if err != nil {
+				return gameLookupError(err)
+			}
+			if !canAccessGame(c, g) {
+				return session.ErrForbidden
+			}
+			verified := game.Finished(g) && share.Matches(r, g)
+			result.Verified = &verified
+		}
+
+		return c.Status(fiber.StatusOK).JSON(result)
+	}
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"Wordle/internal/models"
+	"Wordle/internal/response"
+	"Wordle/internal/solver"
+	"Wordle/internal/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SolverCandidatesHandler(c *fiber.Ctx) error {
+	ws, body, history, err := parseSolverBody(c)
+	if err != nil {
+		return err
+	}
+
+	candidates := solver.Candidates(ws.AnswersOfSize(body.Size), history)
+	if candidates == nil {
+		candidates = []string{}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SolverCandidates{
+		Count:      len(candidates),
+		Candidates: candidates,
+	})
+}
+
+func SolverSuggestHandler(c *fiber.Ctx) error {
+	ws, body, history, err := parseSolverBody(c)
+	if err != nil {
+		return err
+	}
+
+	if body.Strategy == "" {
+		body.Strategy = solver.StrategyEntropy
+	}
+	if body.Limit == 0 {
+		body.Limit = 10
+	}
+
+	pool := ws.WordsOfSize(body.Size)
+	candidates := solver.Candidates(ws.AnswersOfSize(body.Size), history)
+	suggestions := solver.Suggest(candidates, pool, body.Strategy, body.Limit)
+
+	resp := response.SolverSuggestions{
+		Strategy:    body.Strategy,
+		Count:       len(candidates),
+		Suggestions: make([]response.SolverSuggestion, 0, len(suggestions)),
+	}
+	for _, s := range suggestions {
+		resp.Suggestions = append(resp.Suggestions, response.SolverSuggestion{
+			Word:      s.Word,
+			Score:     s.Score,
+			Candidate: s.Candidate,
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// parseSolverBody parses and validates a solver request.
+func parseSolverBody(c *fiber.Ctx) (*utils.WordSet, response.BodySolverPost, []models.Guess, error) {
+	var body response.BodySolverPost
+	if err := c.BodyParser(&body); err != nil {
+		return nil, body, nil, newError(fiber.StatusBadRequest, response.CodeInvalidJSON, "Invalid JSON")
+	}
+
+	if err := guessValidate.Struct(&body); err != nil {
+		return nil, body, nil, err
+	}
+
+	ws, err := utils.Lookup(body.Lang)
+	if err != nil {
+		return nil, body, nil, err
+	}
+
+	if body.Size == 0 {
+		body.Size = 5
+		if len(body.History) > 0 {
+			body.Size = utils.WordLength(ws.Normalize(body.History[0].Guess))
+		}
+	}
+
+	history := make([]models.Guess, 0, len(body.History))
+	for _, h := range body.History {
+		word := ws.Normalize(h.Guess)
+		if utils.WordLength(word) != body.Size || len(h.Feedback) != body.Size {
+			return nil, body, nil, newError(fiber.StatusBadRequest, response.CodeWrongLength,
+				"Every guess and its feedback must match the specified size")
+		}
+		history = append(history, models.Guess{
+			Word:     word,
+			Feedback: h.Feedback,
+		})
+	}
+	return ws, body, history, nil
+}
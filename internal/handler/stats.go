@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"Wordle/internal/database"
+	"Wordle/internal/models"
+	"Wordle/internal/response"
+	"Wordle/internal/stats"
+	"Wordle/internal/utils"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func UserStatsHandler(db database.Service, schedule *utils.DailySchedule) func(*fiber.Ctx) error {
+
+	return func(c *fiber.Ctx) error {
+		user := currentUser(c)
+		st, err := db.GetStats(c.UserContext(), user.ID)
+		if errors.Is(err, database.ErrNotFound) {
+			st = &models.Stats{UserID: user.ID}
+		} else if err != nil {
+			return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to load stats")
+		}
+
+		distribution := st.Distribution
+		if distribution == nil {
+			distribution = []int{}
+		}
+
+		return c.Status(fiber.StatusOK).JSON(response.UserStats{
+			GamesPlayed:       st.Played,
+			Wins:              st.Wins,
+			WinPercentage:     stats.WinPercentage(st),
+			CurrentStreak:     stats.CurrentStreak(st, schedule.PuzzleNumber(time.Now())),
+			MaxStreak:         st.MaxStreak,
+			GuessDistribution: distribution,
+		})
+	}
+}
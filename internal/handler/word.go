@@ -1,23 +1,32 @@
 package handler
 
 import (
+	"Wordle/internal/response"
 	"Wordle/internal/utils"
+	"net/url"
 
 	"github.com/gofiber/fiber/v2"
 )
 
 func WordHandler(c *fiber.Ctx) error {
-	word := c.Params("word")
-	guess := c.Query("guess")
+	ws, err := utils.Lookup(c.Query("lang"))
+	if err != nil {
+		return newError(fiber.StatusBadRequest, response.CodeUnsupportedLanguage, "Unsupported language")
+	}
+
+	// Path parameters arrive percent-encoded, so non-ASCII words must be decoded first
+	rawWord, err := url.PathUnescape(c.Params("word"))
+	if err != nil {
+		return newError(fiber.StatusBadRequest, response.CodeInvalidWord, "Invalid word")
+	}
+
+	word := ws.Normalize(rawWord)
+	guess := ws.Normalize(c.Query("guess"))
 	if guess == "" {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Query parameter 'guess' is required",
-		})
+		return newError(fiber.StatusBadRequest, response.CodeBadRequest, "Query parameter 'guess' is required")
 	}
-	if len(word) != len(guess) {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Guess must be the same length as the word",
-		})
+	if utils.WordLength(word) != utils.WordLength(guess) {
+		return newError(fiber.StatusBadRequest, response.CodeWrongLength, "Guess must be the same length as the word")
 	}
 
 	results := utils.CompareWords(guess, word)
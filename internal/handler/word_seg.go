@@ -2,6 +2,7 @@ package handler
 
 import (
 	"Wordle/internal/database"
+	"Wordle/internal/models"
 	"Wordle/internal/response"
 	"Wordle/internal/utils"
 
@@ -9,32 +10,58 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
-func WordSegHandler(db *database.Service) func(*fiber.Ctx) error {
+// WordSegHandler submits a custom word for moderation. The word only becomes
+// valid once an admin approves it.
+func WordSegHandler(db database.Service) func(*fiber.Ctx) error {
 
 	return func(c *fiber.Ctx) error {
 		var body response.BodyWordsegPost
 		if err := c.BodyParser(&body); err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-				"error": "Invalid JSON",
-			})
+			return newError(fiber.StatusBadRequest, response.CodeInvalidJSON, "Invalid JSON")
 		}
 		validate := validator.New(validator.WithRequiredStructEnabled())
 
 		if err := validate.Struct(&body); err != nil {
-			validationErrors := parseValidationErrors(err)
-			return c.Status(fiber.StatusUnprocessableEntity).JSON(response.HTTPValidationError{
-				Detail: validationErrors,
-			})
+			return err
 		}
 
-		err := utils.AddNewWord(body.Text)
+		ws, err := utils.Lookup(body.Lang)
 		if err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-				"error": err.Error(),
-			})
+			return newError(fiber.StatusBadRequest, response.CodeUnsupportedLanguage, "Unsupported language")
 		}
-		return c.Status(fiber.StatusOK).JSON(fiber.Map{
-			"message": "Text processed successfully",
-		})
+
+		word := ws.Normalize(body.Text)
+		if !ws.Language.IsWord(word) {
+			return newError(fiber.StatusBadRequest, response.CodeInvalidWord, "word must contain only alphabetic characters")
+		}
+		if ws.Words.Contains(word) {
+			return utils.ErrWordExists
+		}
+
+		submission := &models.Word{
+			Lang:    ws.Language.Code,
+			Content: word,
+			Status:  models.WordPending,
+		}
+		if user := currentUser(c); user != nil {
+			submission.UserID = user.ID
+		}
+
+		if err := db.CreateWord(c.UserContext(), submission); err != nil {
+			return newError(fiber.StatusInternalServerError, response.CodeInternal, "Failed to save submission")
+		}
+		return c.Status(fiber.StatusAccepted).JSON(wordSubmission(submission))
+	}
+}
+
+func wordSubmission(w *models.Word) response.WordSubmission {
+	return response.WordSubmission{
+		ID:         w.ID,
+		Lang:       w.Lang,
+		Word:       w.Content,
+		Status:     w.Status,
+		UserID:     w.UserID,
+		CreatedAt:  w.CreatedAt,
+		ReviewedAt: w.ReviewedAt,
 	}
 }
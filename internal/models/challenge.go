@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// Challenge is a puzzle a player set for others. The word is stored sealed
+// so the challenge can be looked up by its short code without spoiling it.
+type Challenge struct {
+	ID          string    `bson:"_id" json:"code"`
+	Lang        string    `bson:"lang" json:"lang"`
+	Size        int       `bson:"size" json:"size"`
+	MaxAttempts int       `bson:"max_attempts" json:"max_attempts"`
+	Sealed      string    `bson:"sealed" json:"-"` // puzzle.Codec token for the word
+	Custom      bool      `bson:"custom" json:"custom"`
+	CreatorID   string    `bson:"creator_id,omitempty" json:"creator_id,omitempty"`
+	Attempts    int       `bson:"attempts" json:"attempts"` // Players who made at least one guess
+	Solves      int       `bson:"solves" json:"solves"`
+	CreatedAt   time.Time `bson:"created_at" json:"created_at"`
+}
@@ -0,0 +1,8 @@
+package models
+
+// DailyResults counts how a daily puzzle has gone for everyone so far.
+type DailyResults struct {
+	PuzzleNumber int `bson:"-" json:"puzzle_number"`
+	Played       int `bson:"played" json:"played"` // Finished games, won or lost
+	Solved       int `bson:"solved" json:"solved"`
+}
@@ -0,0 +1,46 @@
+// models/game.go
+package models
+
+import (
+	"time"
+
+	"Wordle/internal/response"
+)
+
+// Game is a single Wordle session: one hidden target and a bounded number of
+// guesses. Multi-board games score every guess against several Targets instead,
+// and absurdle games narrow down Candidates until a single word remains.
+type Game struct {
+	ID           string     `bson:"_id" json:"id"`
+	Mode         string     `bson:"mode,omitempty" json:"mode,omitempty"`       // Values: "classic", "dordle", "quordle", "octordle", "absurdle"; empty means classic
+	UserID       string     `bson:"user_id,omitempty" json:"user_id,omitempty"` // Empty for anonymous games
+	Lang         string     `bson:"lang" json:"lang"`
+	Size         int        `bson:"size" json:"size"`
+	MaxAttempts  int        `bson:"max_attempts" json:"max_attempts"`
+	Target       string     `bson:"target" json:"-"`               // Never serialised; revealed explicitly once the game ends
+	Targets      []string   `bson:"targets,omitempty" json:"-"`    // One per board in multi-board games, which leave Target empty
+	Solved       []int      `bson:"solved,omitempty" json:"-"`     // Guesses each board took to solve, 0 while unsolved
+	Candidates   []string   `bson:"candidates,omitempty" json:"-"` // Absurdle games: answers still consistent with every guess
+	HardMode     bool       `bson:"hard_mode" json:"hard_mode"`
+	PuzzleNumber int        `bson:"puzzle_number,omitempty" json:"puzzle_number,omitempty"` // Daily puzzle played, 0 for free play
+	Seeded       bool       `bson:"seeded,omitempty" json:"seeded,omitempty"`               // Target chosen by the client; kept out of stats and leaderboards
+	Hinted       bool       `bson:"hinted,omitempty" json:"hinted,omitempty"`               // A hint was shown; kept out of stats and leaderboards
+	PuzzleToken  string     `bson:"puzzle_token" json:"-"`                                  // Sealed target handed out for sharing and replays
+	ChallengeID  string     `bson:"challenge_id,omitempty" json:"challenge_id,omitempty"`   // Challenge the game plays, if any
+	Guesses      []Guess    `bson:"guesses" json:"guesses"`
+	Status       string     `bson:"status" json:"status"` // Values: "in_progress", "won", "lost"
+	Version      int        `bson:"version" json:"-"`     // Incremented on every update for optimistic locking
+	CreatedAt    time.Time  `bson:"created_at" json:"created_at"`
+	UpdatedAt    time.Time  `bson:"updated_at" json:"updated_at"`
+	FinishedAt   *time.Time `bson:"finished_at,omitempty" json:"finished_at,omitempty"`
+}
+
+// Guess is one attempt within a game together with the feedback it produced.
+type Guess struct {
+	Word     string                    `bson:"word" json:"word"`
+	Feedback []response.LetterFeedback `bson:"feedback" json:"feedback"`
+
+	// Boards holds the feedback for every board of a multi-board game, nil
+	// for boards that were already solved before this guess.
+	Boards [][]response.LetterFeedback `bson:"boards,omitempty" json:"boards,omitempty"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// LeaderboardEntry is one ranked player. Which fields are filled in depends
+// on the board: daily boards rank a single game, all-time boards rank Stats.
+type LeaderboardEntry struct {
+	UserID   string `bson:"_id" json:"user_id"`
+	Username string `bson:"username" json:"username"`
+
+	Guesses    int       `bson:"guesses,omitempty" json:"guesses,omitempty"`
+	SolveMs    int64     `bson:"solve_ms,omitempty" json:"solve_ms,omitempty"` // From game creation to the winning guess
+	FinishedAt time.Time `bson:"finished_at,omitempty" json:"finished_at,omitempty"`
+
+	Played    int `bson:"played,omitempty" json:"played,omitempty"`
+	Wins      int `bson:"wins,omitempty" json:"wins,omitempty"`
+	MaxStreak int `bson:"max_streak,omitempty" json:"max_streak,omitempty"`
+}
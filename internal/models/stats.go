@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// Stats are a player's running totals, updated whenever one of their games finishes.
+type Stats struct {
+	UserID        string    `bson:"_id" json:"user_id"`
+	Played        int       `bson:"played" json:"played"`
+	Wins          int       `bson:"wins" json:"wins"`
+	CurrentStreak int       `bson:"current_streak" json:"current_streak"`
+	MaxStreak     int       `bson:"max_streak" json:"max_streak"`
+	Distribution  []int     `bson:"distribution" json:"distribution"` // Distribution[i] counts wins in i+1 guesses
+	LastPuzzle    int       `bson:"last_puzzle" json:"last_puzzle"`   // Most recent daily puzzle recorded, 0 if none
+	Version       int       `bson:"version" json:"-"`                 // Incremented on every save for optimistic locking
+	UpdatedAt     time.Time `bson:"updated_at" json:"updated_at"`
+}
@@ -1,10 +1,12 @@
 package models
 
-import "gorm.io/gorm"
+import "time"
 
 type User struct {
-	gorm.Model
-	Username string `gorm:"unique;not null"`
-	Password string `gorm:"not null"` // In practice, store hashed passwords
-	Words    []Word
+	ID        string    `bson:"_id" json:"id"`
+	Username  string    `bson:"username" json:"username"`
+	Password  string    `bson:"password" json:"-"` // bcrypt hash, see auth.HashPassword
+	Words     []Word    `bson:"-" json:"words,omitempty"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+	UpdatedAt time.Time `bson:"updated_at" json:"updated_at"`
 }
@@ -1,10 +1,22 @@
 // models/word.go
 package models
 
-import "gorm.io/gorm"
+import "time"
 
+// Word submission statuses
+const (
+	WordPending  = "pending"
+	WordApproved = "approved"
+	WordRejected = "rejected"
+)
+
+// Word is a custom word submitted by a user for inclusion in a word list.
 type Word struct {
-	gorm.Model
-	Content string `gorm:"not null"`
-	UserID  uint
+	ID         string     `bson:"_id" json:"id"`
+	Lang       string     `bson:"lang" json:"lang"`
+	Content    string     `bson:"content" json:"content"`
+	UserID     string     `bson:"user_id" json:"user_id"`
+	Status     string     `bson:"status" json:"status"` // Values: "pending", "approved", "rejected"
+	CreatedAt  time.Time  `bson:"created_at" json:"created_at"`
+	ReviewedAt *time.Time `bson:"reviewed_at,omitempty" json:"reviewed_at,omitempty"`
 }
@@ -0,0 +1,13 @@
+// models/word_tier.go
+package models
+
+import "time"
+
+// WordTier records an admin override of whether a word can be picked as an answer.
+type WordTier struct {
+	ID        string    `bson:"_id" json:"id"` // "<lang>:<word>"
+	Lang      string    `bson:"lang" json:"lang"`
+	Word      string    `bson:"word" json:"word"`
+	Tier      string    `bson:"tier" json:"tier"` // Values: "answer", "guess"
+	UpdatedAt time.Time `bson:"updated_at" json:"updated_at"`
+}
@@ -0,0 +1,34 @@
+package openapi
+
+import (
+	_ "embed"
+	"io/fs"
+
+	swaggerFiles "github.com/swaggo/files/v2"
+)
+
+// DocsPage is an HTML page rendering the document served at /openapi.json.
+//
+//go:embed docs.html
+var DocsPage []byte
+
+//go:embed docs.js
+var docsScript []byte
+
+// DocsAsset returns a file DocsPage loads from /docs/, and its content type.
+// The Swagger UI files are embedded from a Go module, so the page works
+// offline and runs no script the module checksums do not pin. Other names
+// fail with fs.ErrNotExist.
+func DocsAsset(name string) ([]byte, string, error) {
+	switch name {
+	case "docs.js":
+		return docsScript, "text/javascript; charset=utf-8", nil
+	case "swagger-ui-bundle.js":
+		data, err := fs.ReadFile(swaggerFiles.FS, name)
+		return data, "text/javascript; charset=utf-8", err
+	case "swagger-ui.css":
+		data, err := fs.ReadFile(swaggerFiles.FS, name)
+		return data, "text/css; charset=utf-8", err
+	}
+	return nil, "", fs.ErrNotExist
+}
@@ -0,0 +1,6 @@
+// Renders the API description with the Swagger UI bundle served next to this script
+window.ui = SwaggerUIBundle({
+  url: "/openapi.json",
+  dom_id: "#docs",
+  deepLinking: true,
+});
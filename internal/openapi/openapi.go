@@ -0,0 +1,298 @@
+// Package openapi builds an OpenAPI 3 document from route descriptions and
+// the request and response types the handlers already use. Schemas are
+// derived from the types by reflection: json tags name the properties and
+// validate tags add the constraints, so the document cannot drift from what
+// the handlers accept.
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"Wordle/internal/response"
+)
+
+// Version is the OpenAPI version of the generated documents.
+const Version = "3.0.3"
+
+// Document is an OpenAPI 3 document. Only the parts this API uses are modelled.
+type Document struct {
+	OpenAPI    string                           `json:"openapi"`
+	Info       Info                             `json:"info"`
+	Paths      map[string]map[string]*Operation `json:"paths"`
+	Components Components                       `json:"components"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes"`
+}
+
+type SecurityScheme struct {
+	Type        string `json:"type"`
+	Scheme      string `json:"scheme,omitempty"`
+	In          string `json:"in,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type Operation struct {
+	Summary     string                `json:"summary,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Parameters  []*Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"` // Values: "path", "query", "header"
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                  `json:"required,omitempty"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Schema is the subset of JSON Schema used by OpenAPI 3.0.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+}
+
+// Auth says who may call a route.
+type Auth int
+
+const (
+	AuthNone     Auth = iota // Public
+	AuthOptional             // Public, but a bearer token ties the result to its player
+	AuthUser                 // Requires a bearer token
+	AuthAdmin                // Requires the X-Admin-Token header
+)
+
+// Param describes a path or query parameter that is not read through a
+// query struct.
+type Param struct {
+	Name        string
+	Type        string // JSON type; "string" when empty
+	Description string
+	Required    bool
+	Enum        []string
+}
+
+// Reply is one documented response of a route. Body is a value of the
+// response type, nil for an empty body.
+type Reply struct {
+	Status      int
+	Description string
+	Body        any
+	ContentType string // "application/json" when empty
+}
+
+// Route describes one registered route. Path uses Fiber's :param syntax.
+type Route struct {
+	Method  string
+	Path    string
+	Summary string
+	Tags    []string
+	Auth    Auth
+
+	Params []Param // Path parameters default to strings and need no entry
+	Query  any     // A struct read with QueryParser
+	Extra  []Param // Query parameters read one by one
+	Body   any     // The JSON request body
+
+	Replies []Reply
+	// Errors lists the error statuses of the route, which all answer with
+	// response.Error.
+	Errors []int
+}
+
+// New creates an empty document with the shared security schemes.
+func New(info Info) *Document {
+	return &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   map[string]map[string]*Operation{},
+		Components: Components{
+			Schemas: map[string]*Schema{},
+			SecuritySchemes: map[string]*SecurityScheme{
+				"bearerAuth": {
+					Type:        "http",
+					Scheme:      "bearer",
+					Description: "Session token from /auth/register or /auth/login",
+				},
+				"adminToken": {
+					Type: "apiKey",
+					In:   "header",
+					Name: "X-Admin-Token",
+				},
+			},
+		},
+	}
+}
+
+// Add documents routes.
+func (d *Document) Add(routes ...Route) {
+	for _, r := range routes {
+		path, params := Path(r.Path)
+		op := &Operation{
+			Summary:   r.Summary,
+			Tags:      r.Tags,
+			Responses: map[string]*Response{},
+			Security:  security(r.Auth),
+		}
+
+		for _, name := range params {
+			p := &Parameter{Name: name, In: "path", Required: true, Schema: &Schema{Type: "string"}}
+			for _, given := range r.Params {
+				if given.Name == name {
+					p.Description = given.Description
+					p.Schema = paramSchema(given)
+				}
+			}
+			op.Parameters = append(op.Parameters, p)
+		}
+		if r.Query != nil {
+			op.Parameters = append(op.Parameters, d.queryParams(reflect.TypeOf(r.Query))...)
+		}
+		for _, given := range r.Extra {
+			op.Parameters = append(op.Parameters, &Parameter{
+				Name:        given.Name,
+				In:          "query",
+				Description: given.Description,
+				Required:    given.Required,
+				Schema:      paramSchema(given),
+			})
+		}
+
+		if r.Body != nil {
+			op.RequestBody = &RequestBody{
+				Required: true,
+				Content:  map[string]*MediaType{"application/json": {Schema: d.Schema(reflect.TypeOf(r.Body))}},
+			}
+		}
+
+		for _, reply := range r.Replies {
+			resp := &Response{Description: reply.Description}
+			if resp.Description == "" {
+				resp.Description = http.StatusText(reply.Status)
+			}
+			if reply.Body != nil || reply.ContentType != "" {
+				contentType := reply.ContentType
+				if contentType == "" {
+					contentType = "application/json"
+				}
+				schema := &Schema{Type: "string"}
+				if reply.Body != nil {
+					schema = d.Schema(reflect.TypeOf(reply.Body))
+				}
+				resp.Content = map[string]*MediaType{contentType: {Schema: schema}}
+			}
+			op.Responses[strconv.Itoa(reply.Status)] = resp
+		}
+		for _, status := range r.Errors {
+			op.Responses[strconv.Itoa(status)] = &Response{
+				Description: http.StatusText(status),
+				Content:     map[string]*MediaType{"application/json": {Schema: d.errorSchema()}},
+			}
+		}
+
+		if d.Paths[path] == nil {
+			d.Paths[path] = map[string]*Operation{}
+		}
+		d.Paths[path][strings.ToLower(r.Method)] = op
+	}
+}
+
+// errorSchema references response.Error and lists the error codes its code
+// property may hold.
+func (d *Document) errorSchema() *Schema {
+	ref := d.Schema(reflect.TypeOf(response.Error{}))
+	code := d.Components.Schemas["Error"].Properties["code"]
+	if code.Enum == nil {
+		for _, c := range response.ErrorCodes {
+			code.Enum = append(code.Enum, c)
+		}
+	}
+	return ref
+}
+
+// Has reports whether the document describes method on a Fiber path.
+func (d *Document) Has(method, path string) bool {
+	path, _ = Path(path)
+	_, ok := d.Paths[path][strings.ToLower(method)]
+	return ok
+}
+
+// Path converts a Fiber path to OpenAPI's {param} syntax and returns the
+// names of its parameters.
+func Path(fiberPath string) (string, []string) {
+	segments := strings.Split(fiberPath, "/")
+	var params []string
+	for i, s := range segments {
+		if name, ok := strings.CutPrefix(s, ":"); ok {
+			segments[i] = "{" + name + "}"
+			params = append(params, name)
+		}
+	}
+	return strings.Join(segments, "/"), params
+}
+
+func security(auth Auth) []map[string][]string {
+	switch auth {
+	case AuthOptional:
+		return []map[string][]string{{}, {"bearerAuth": {}}}
+	case AuthUser:
+		return []map[string][]string{{"bearerAuth": {}}}
+	case AuthAdmin:
+		return []map[string][]string{{"adminToken": {}}}
+	}
+	return nil
+}
+
+func paramSchema(p Param) *Schema {
+	s := &Schema{Type: p.Type}
+	if s.Type == "" {
+		s.Type = "string"
+	}
+	for _, v := range p.Enum {
+		s.Enum = append(s.Enum, v)
+	}
+	return s
+}
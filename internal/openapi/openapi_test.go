@@ -0,0 +1,109 @@
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"Wordle/internal/response"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+		params   []string
+	}{
+		{path: "/", expected: "/"},
+		{path: "/daily/", expected: "/daily/"},
+		{path: "/games/:id/guesses", expected: "/games/{id}/guesses", params: []string{"id"}},
+		{path: "/a/:x/b/:y", expected: "/a/{x}/b/{y}", params: []string{"x", "y"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.path, func(t *testing.T) {
+			path, params := Path(tt.path)
+			assert.Equal(t, tt.expected, path)
+			assert.Equal(t, tt.params, params)
+		})
+	}
+}
+
+func TestSchema(t *testing.T) {
+	type sample struct {
+		Name    string     `json:"name" validate:"required,min=3,max=32"`
+		Kind    string     `json:"kind" validate:"omitempty,oneof=a b"`
+		Count   int        `json:"count,omitempty" validate:"omitempty,min=1"`
+		Tags    []string   `json:"tags" validate:"max=4,dive,required"`
+		When    time.Time  `json:"when"`
+		Maybe   *int       `json:"maybe"`
+		Skipped string     `json:"-"`
+		Nested  GuessEntry `json:"nested"`
+	}
+
+	doc := New(Info{Title: "test"})
+	ref := doc.Schema(reflect.TypeOf(sample{}))
+	assert.Equal(t, "#/components/schemas/sample", ref.Ref)
+
+	s := doc.Components.Schemas["sample"]
+	assert.Equal(t, "object", s.Type)
+	assert.Equal(t, []string{"name"}, s.Required)
+	assert.NotContains(t, s.Properties, "Skipped")
+	assert.NotContains(t, s.Properties, "-")
+
+	assert.Equal(t, 3, *s.Properties["name"].MinLength)
+	assert.Equal(t, 32, *s.Properties["name"].MaxLength)
+	assert.Equal(t, []any{"a", "b"}, s.Properties["kind"].Enum)
+	assert.Equal(t, 1.0, *s.Properties["count"].Minimum)
+	assert.Equal(t, 4, *s.Properties["tags"].MaxItems)
+	assert.Equal(t, "string", s.Properties["tags"].Items.Type)
+	assert.Equal(t, "date-time", s.Properties["when"].Format)
+	assert.True(t, s.Properties["maybe"].Nullable)
+
+	// Named structs become shared components
+	assert.Equal(t, "#/components/schemas/GuessEntry", s.Properties["nested"].Ref)
+	assert.Contains(t, doc.Components.Schemas["GuessEntry"].Properties, "word")
+}
+
+// GuessEntry is a named type for TestSchema.
+type GuessEntry struct {
+	Word string `json:"word"`
+}
+
+func TestAdd(t *testing.T) {
+	type query struct {
+		Page int `query:"page" validate:"omitempty,min=1"`
+		Q    string
+	}
+
+	doc := New(Info{Title: "test"})
+	doc.Add(Route{
+		Method:  http.MethodPost,
+		Path:    "/games/:id/guesses",
+		Auth:    AuthUser,
+		Params:  []Param{{Name: "id", Description: "Game ID"}},
+		Query:   query{},
+		Body:    response.BodyGuessPost{},
+		Replies: []Reply{{Status: http.StatusOK, Body: response.GameGuessResponse{}}},
+		Errors:  []int{http.StatusNotFound, http.StatusUnprocessableEntity},
+	})
+
+	assert.True(t, doc.Has("POST", "/games/:id/guesses"))
+	assert.False(t, doc.Has("GET", "/games/:id/guesses"))
+
+	op := doc.Paths["/games/{id}/guesses"]["post"]
+	assert.Len(t, op.Parameters, 2)
+	assert.Equal(t, "path", op.Parameters[0].In)
+	assert.Equal(t, "Game ID", op.Parameters[0].Description)
+	assert.Equal(t, "page", op.Parameters[1].Name)
+	assert.Equal(t, "#/components/schemas/BodyGuessPost", op.RequestBody.Content["application/json"].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/GameGuessResponse", op.Responses["200"].Content["application/json"].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/Error", op.Responses["404"].Content["application/json"].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/Error", op.Responses["422"].Content["application/json"].Schema.Ref)
+	assert.Contains(t, doc.Components.Schemas["Error"].Properties["code"].Enum, response.CodeNotFound)
+	assert.Equal(t, []map[string][]string{{"bearerAuth": {}}}, op.Security)
+}
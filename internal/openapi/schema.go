@@ -0,0 +1,153 @@
+package openapi
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Schema returns the schema of t. Named structs are added to the document's
+// components once and referenced from then on.
+func (d *Document) Schema(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: d.Schema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: d.Schema(t.Elem())}
+	case reflect.Struct:
+		if t == timeType {
+			return &Schema{Type: "string", Format: "date-time"}
+		}
+		if t.Name() == "" {
+			return d.object(t)
+		}
+		ref := &Schema{Ref: "#/components/schemas/" + t.Name()}
+		if _, ok := d.Components.Schemas[t.Name()]; !ok {
+			// Register before recursing so self-referencing types terminate
+			d.Components.Schemas[t.Name()] = &Schema{}
+			*d.Components.Schemas[t.Name()] = *d.object(t)
+		}
+		return ref
+	}
+	// Interfaces and anything else can hold any JSON value
+	return &Schema{}
+}
+
+// object describes a struct's exported JSON fields.
+func (d *Document) object(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	for _, f := range reflect.VisibleFields(t) {
+		if !f.IsExported() || f.Anonymous {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		prop := d.Schema(f.Type)
+		if f.Type.Kind() == reflect.Pointer && prop.Ref == "" {
+			prop.Nullable = true
+		}
+		if constrain(prop, f.Type, f.Tag.Get("validate")) {
+			s.Required = append(s.Required, name)
+		}
+		s.Properties[name] = prop
+	}
+	return s
+}
+
+// queryParams describes the fields of a struct read with Fiber's
+// QueryParser.
+func (d *Document) queryParams(t reflect.Type) []*Parameter {
+	var params []*Parameter
+	for _, f := range reflect.VisibleFields(t) {
+		name := f.Tag.Get("query")
+		if !f.IsExported() || name == "" {
+			continue
+		}
+		p := &Parameter{Name: name, In: "query", Schema: d.Schema(f.Type)}
+		p.Required = constrain(p.Schema, f.Type, f.Tag.Get("validate"))
+		params = append(params, p)
+	}
+	return params
+}
+
+// constrain applies the validate rules of a field to its schema and reports
+// whether the field is required. Rules without a schema equivalent, such as
+// excluded_with, are left to the description of the route.
+func constrain(s *Schema, t reflect.Type, rules string) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	required := false
+	for _, rule := range strings.Split(rules, ",") {
+		if rule == "dive" {
+			// Later rules apply to the elements
+			break
+		}
+		name, arg, _ := strings.Cut(rule, "=")
+		switch name {
+		case "required":
+			required = true
+		case "oneof":
+			for _, v := range strings.Fields(arg) {
+				s.Enum = append(s.Enum, v)
+			}
+		case "min", "max":
+			n, err := strconv.Atoi(arg)
+			if err != nil {
+				continue
+			}
+			bound(s, t.Kind(), name == "min", n)
+		}
+	}
+	return required
+}
+
+// bound sets a min or max rule, which validator applies to the length of
+// strings and slices and to the value of numbers.
+func bound(s *Schema, kind reflect.Kind, isMin bool, n int) {
+	switch kind {
+	case reflect.String:
+		if isMin {
+			s.MinLength = &n
+		} else {
+			s.MaxLength = &n
+		}
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if isMin {
+			s.MinItems = &n
+		} else {
+			s.MaxItems = &n
+		}
+	default:
+		v := float64(n)
+		if isMin {
+			s.Minimum = &v
+		} else {
+			s.Maximum = &v
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Package puzzle turns a target word into an opaque token that can be handed
+// to clients so a puzzle can be replayed or shared. Tokens are sealed with
+// AES-GCM: clients can neither read the word inside one nor forge one for a
+// word of their choosing.
+package puzzle
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+var ErrInvalidToken = errors.New("invalid puzzle token")
+
+// Ref is the target selection a token stands for.
+type Ref struct {
+	Lang string `json:"l"`
+	Word string `json:"w"`
+}
+
+// Codec seals and opens puzzle tokens with a key derived from a server secret.
+type Codec struct {
+	aead cipher.AEAD
+}
+
+func NewCodec(secret []byte) (*Codec, error) {
+	key := sha256.Sum256(secret)
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Codec{aead: aead}, nil
+}
+
+// Encode seals ref into a URL-safe token. Every call uses a fresh nonce, so
+// the same puzzle yields a different token each time and tokens cannot be
+// compared to find out whether two puzzles share an answer.
+func (c *Codec) Encode(ref Ref) (string, error) {
+	plain, err := json.Marshal(ref)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := c.aead.Seal(nonce, nonce, plain, nil)
+	return base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// Decode opens a token produced by Encode with the same secret.
+func (c *Codec) Decode(token string) (Ref, error) {
+	var ref Ref
+	sealed, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(sealed) < c.aead.NonceSize() {
+		return ref, ErrInvalidToken
+	}
+
+	nonce, ciphertext := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+	plain, err := c.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return ref, ErrInvalidToken
+	}
+	if err := json.Unmarshal(plain, &ref); err != nil || ref.Word == "" {
+		return ref, ErrInvalidToken
+	}
+	return ref, nil
+}
@@ -0,0 +1,59 @@
+package puzzle
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCodec(t *testing.T) {
+	codec, err := NewCodec([]byte("secret"))
+	if err != nil {
+		t.Fatalf("NewCodec() returned error: %v", err)
+	}
+
+	ref := Ref{Lang: "de", Word: "straße"}
+	token, err := codec.Encode(ref)
+	if err != nil {
+		t.Fatalf("Encode() returned error: %v", err)
+	}
+	if strings.Contains(token, "stra") {
+		t.Errorf("Encode() = %q leaks the word", token)
+	}
+
+	again, _ := codec.Encode(ref)
+	if again == token {
+		t.Errorf("Encode() returned the same token twice")
+	}
+
+	got, err := codec.Decode(token)
+	if err != nil {
+		t.Fatalf("Decode() returned error: %v", err)
+	}
+	if got != ref {
+		t.Errorf("Decode() = %+v; want %+v", got, ref)
+	}
+
+	other, _ := NewCodec([]byte("other secret"))
+	tampered := token[:len(token)-2] + "AA"
+
+	tests := []struct {
+		name  string
+		codec *Codec
+		token string
+	}{
+		{"Different secret", other, token},
+		{"Tampered token", codec, tampered},
+		{"Not base64", codec, "not a token!"},
+		{"Too short", codec, "AAAA"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.codec.Decode(tt.token); !errors.Is(err, ErrInvalidToken) {
+				t.Errorf("Decode() error = %v; want %v", err, ErrInvalidToken)
+			}
+		})
+	}
+}
@@ -0,0 +1,576 @@
+// Package race runs head-to-head rooms in which every player races to solve
+// the same target. It is transport independent: players receive Events on a
+// channel and act through Room methods, and the WebSocket handler only
+// shuttles JSON between the two.
+//
+// Other players only ever see the colours of a guess, never its letters.
+package race
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"Wordle/internal/game"
+	"Wordle/internal/models"
+	"Wordle/internal/response"
+)
+
+// Room states.
+const (
+	StateWaiting  = "waiting"
+	StatePlaying  = "playing"
+	StateFinished = "finished"
+)
+
+// Reasons a room finished.
+const (
+	ReasonSolved    = "solved"    // Someone found the word
+	ReasonExhausted = "exhausted" // Every player ran out of attempts or left
+	ReasonTimeout   = "timeout"   // The time limit passed
+)
+
+const (
+	MinPlayers        = 2
+	DefaultMaxPlayers = 8
+	DefaultTimeLimit  = 5 * time.Minute
+	// eventBuffer bounds how far a player's connection may fall behind
+	// before it is dropped rather than blocking the room
+	eventBuffer = 32
+)
+
+var (
+	ErrRoomNotFound      = errors.New("room not found")
+	ErrRoomFull          = errors.New("room is full")
+	ErrRoomStarted       = errors.New("the race has already started")
+	ErrRoomFinished      = errors.New("the race is over")
+	ErrNotStarted        = errors.New("the race has not started yet")
+	ErrNameTaken         = errors.New("name is already taken in this room")
+	ErrNotHost           = errors.New("only the host can start the race")
+	ErrNotEnoughPlayers  = errors.New("at least 2 players are needed to start")
+	ErrUnknownPlayer     = errors.New("unknown player")
+	ErrPlayerNotInGame   = errors.New("you are no longer playing")
+	ErrInvalidPlayerName = errors.New("player name must be between 1 and 32 characters")
+)
+
+// Options configures a new room. Zero values fall back to the defaults.
+type Options struct {
+	Lang        string
+	Size        int
+	MaxAttempts int
+	MaxPlayers  int
+	TimeLimit   time.Duration
+}
+
+// Event is pushed to players as things happen in their room.
+type Event struct {
+	Type string `json:"type"` // Values: "state", "joined", "disconnected", "reconnected", "left", "started", "guess", "progress", "finished", "error"
+	// Player is the public name of the player the event is about
+	Player string `json:"player,omitempty"`
+	// PlayerID is only sent to its owner, who needs it to reconnect
+	PlayerID string     `json:"player_id,omitempty"`
+	Room     *RoomState `json:"room,omitempty"`
+	// Feedback answers a player's own guess and is never sent to others
+	Feedback []response.LetterFeedback `json:"feedback,omitempty"`
+	// Row is the colours of another player's guess
+	Row    []string `json:"row,omitempty"`
+	Status string   `json:"status,omitempty"` // The player's game status
+	Winner string   `json:"winner,omitempty"`
+	Reason string   `json:"reason,omitempty"`
+	Target string   `json:"target,omitempty"`
+	Error  string   `json:"error,omitempty"`
+}
+
+// RoomState is a snapshot of a room as a given player may see it.
+type RoomState struct {
+	Code        string        `json:"code"`
+	Lang        string        `json:"lang"`
+	Size        int           `json:"size"`
+	MaxAttempts int           `json:"max_attempts"`
+	State       string        `json:"state"`
+	Host        string        `json:"host"`
+	Deadline    *time.Time    `json:"deadline,omitempty"`
+	Players     []PlayerState `json:"players"`
+	// Guesses are the receiving player's own guesses, letters included
+	Guesses []response.GuessRecord `json:"guesses,omitempty"`
+}
+
+// PlayerState is the public progress of one player: colours only.
+type PlayerState struct {
+	Name      string     `json:"name"`
+	Connected bool       `json:"connected"`
+	Status    string     `json:"status"` // Values: "in_progress", "won", "lost", "left"
+	Rows      [][]string `json:"rows"`
+}
+
+// Player is one seat in a room.
+type Player struct {
+	ID   string
+	Name string
+
+	room      *Room
+	game      *models.Game
+	left      bool
+	connected bool
+	events    chan Event
+	grace     *time.Timer
+}
+
+// Events returns the channel the player's events arrive on. It is closed
+// when the player disconnects, reconnects elsewhere or falls too far behind.
+func (p *Player) Events() <-chan Event {
+	p.room.mu.Lock()
+	defer p.room.mu.Unlock()
+	return p.events
+}
+
+// Room is one race. All methods are safe for concurrent use.
+type Room struct {
+	Code string
+
+	lobby   *Lobby
+	opts    Options
+	target  string
+	mu      sync.Mutex
+	state   string
+	host    string
+	order   []*Player // Join order, for stable listings
+	players map[string]*Player
+	winner  string
+
+	deadline time.Time
+	timer    *time.Timer
+	idle     *time.Timer
+}
+
+// Lobby holds every open room.
+type Lobby struct {
+	// ReconnectGrace is how long a disconnected player keeps their seat.
+	ReconnectGrace time.Duration
+	// IdleTimeout is how long a room with nobody connected is kept around.
+	IdleTimeout time.Duration
+
+	mu    sync.Mutex
+	rooms map[string]*Room
+}
+
+func NewLobby() *Lobby {
+	return &Lobby{
+		ReconnectGrace: 30 * time.Second,
+		IdleTimeout:    10 * time.Minute,
+		rooms:          make(map[string]*Room),
+	}
+}
+
+// Create opens a room with a freshly picked target.
+func (l *Lobby) Create(opts Options) (*Room, error) {
+	if opts.MaxPlayers == 0 {
+		opts.MaxPlayers = DefaultMaxPlayers
+	}
+	if opts.TimeLimit == 0 {
+		opts.TimeLimit = DefaultTimeLimit
+	}
+
+	// Let the game engine pick and validate the target, so a room plays by
+	// exactly the same rules as a single game
+	g, err := game.New(game.Options{Lang: opts.Lang, Size: opts.Size, MaxAttempts: opts.MaxAttempts})
+	if err != nil {
+		return nil, err
+	}
+	opts.Lang, opts.Size, opts.MaxAttempts = g.Lang, g.Size, g.MaxAttempts
+
+	r := &Room{
+		lobby:   l,
+		opts:    opts,
+		target:  g.Target,
+		state:   StateWaiting,
+		players: make(map[string]*Player),
+	}
+
+	l.mu.Lock()
+	for r.Code == "" || l.rooms[r.Code] != nil {
+		if r.Code, err = newCode(6); err != nil {
+			l.mu.Unlock()
+			return nil, err
+		}
+	}
+	l.rooms[r.Code] = r
+	l.mu.Unlock()
+
+	r.mu.Lock()
+	r.scheduleIdle()
+	r.mu.Unlock()
+	return r, nil
+}
+
+// Room looks up an open room by its code.
+func (l *Lobby) Room(code string) (*Room, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	r, ok := l.rooms[code]
+	if !ok {
+		return nil, ErrRoomNotFound
+	}
+	return r, nil
+}
+
+// Join takes a new seat in a room that has not started yet. The first player
+// to join hosts the room.
+func (r *Room) Join(name string) (*Player, error) {
+	if name == "" || len([]rune(name)) > 32 {
+		return nil, ErrInvalidPlayerName
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch {
+	case r.state == StateFinished:
+		return nil, ErrRoomFinished
+	case r.state != StateWaiting:
+		return nil, ErrRoomStarted
+	case r.activePlayers() >= r.opts.MaxPlayers:
+		return nil, ErrRoomFull
+	}
+	for _, other := range r.order {
+		if other.Name == name && !other.left {
+			return nil, ErrNameTaken
+		}
+	}
+
+	id, err := newCode(16)
+	if err != nil {
+		return nil, err
+	}
+	p := &Player{ID: id, Name: name, room: r}
+	r.players[id] = p
+	r.order = append(r.order, p)
+	if r.host == "" {
+		r.host = name
+	}
+
+	r.connect(p)
+	r.broadcast(Event{Type: "joined", Player: name}, p)
+	return p, nil
+}
+
+// Rejoin reclaims a seat after a dropped connection. Rejoining while still
+// connected moves the player to the new connection.
+func (r *Room) Rejoin(playerID string) (*Player, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, ok := r.players[playerID]
+	if !ok || p.left {
+		return nil, ErrUnknownPlayer
+	}
+
+	if p.grace != nil {
+		p.grace.Stop()
+		p.grace = nil
+	}
+	wasConnected := p.connected
+	if wasConnected {
+		close(p.events)
+	}
+	r.connect(p)
+	if !wasConnected {
+		r.broadcast(Event{Type: "reconnected", Player: p.Name}, p)
+	}
+	return p, nil
+}
+
+// Disconnect marks a player's connection as gone. They keep their seat for
+// the lobby's ReconnectGrace before being removed from the race. events must
+// be the channel the connection was reading, so a stale connection closing
+// does not disconnect a player who already reconnected elsewhere.
+func (r *Room) Disconnect(p *Player, events <-chan Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if p.events != events || p.left {
+		return
+	}
+	if p.connected {
+		p.connected = false
+		close(p.events)
+	}
+
+	r.broadcast(Event{Type: "disconnected", Player: p.Name}, p)
+	p.grace = time.AfterFunc(r.lobby.ReconnectGrace, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if !p.connected && !p.left {
+			r.remove(p)
+		}
+	})
+	r.scheduleIdle()
+}
+
+// Start begins the race. Only the host can start it.
+func (r *Room) Start(p *Player) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch {
+	case r.state == StateFinished:
+		return ErrRoomFinished
+	case r.state != StateWaiting:
+		return ErrRoomStarted
+	case p.Name != r.host:
+		return ErrNotHost
+	case r.activePlayers() < MinPlayers:
+		return ErrNotEnoughPlayers
+	}
+
+	for _, player := range r.order {
+		if player.left {
+			continue
+		}
+		g, err := game.New(game.Options{
+			Lang:        r.opts.Lang,
+			Size:        r.opts.Size,
+			MaxAttempts: r.opts.MaxAttempts,
+			Target:      r.target,
+		})
+		if err != nil {
+			return err
+		}
+		player.game = g
+	}
+
+	r.state = StatePlaying
+	r.deadline = time.Now().Add(r.opts.TimeLimit)
+	r.timer = time.AfterFunc(r.opts.TimeLimit, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.state == StatePlaying {
+			r.finish(ReasonTimeout)
+		}
+	})
+
+	for _, player := range r.order {
+		if player.connected {
+			r.send(player, Event{Type: "started", Room: r.snapshot(player)})
+		}
+	}
+	return nil
+}
+
+// Guess plays a word for p. The player sees the full feedback; everyone else
+// only learns the colours.
+func (r *Room) Guess(p *Player, word string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch {
+	case r.state == StateWaiting:
+		return ErrNotStarted
+	case r.state == StateFinished:
+		return ErrRoomFinished
+	case p.left || p.game == nil:
+		return ErrPlayerNotInGame
+	}
+
+	guess, err := game.Play(p.game, word)
+	if err != nil {
+		return err
+	}
+
+	r.send(p, Event{Type: "guess", Player: p.Name, Feedback: guess.Feedback, Status: p.game.Status})
+	r.broadcast(Event{Type: "progress", Player: p.Name, Row: game.Colours(guess.Feedback), Status: p.game.Status}, p)
+
+	switch {
+	case p.game.Status == game.StatusWon:
+		r.winner = p.Name
+		r.finish(ReasonSolved)
+	case r.stillPlaying() == 0:
+		r.finish(ReasonExhausted)
+	}
+	return nil
+}
+
+// State returns the room as p sees it.
+func (r *Room) State(p *Player) *RoomState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.snapshot(p)
+}
+
+// connect gives p a fresh event channel and sends them the current state.
+// The caller must hold r.mu.
+func (r *Room) connect(p *Player) {
+	p.events = make(chan Event, eventBuffer)
+	p.connected = true
+	if r.idle != nil && r.state != StateFinished {
+		r.idle.Stop()
+		r.idle = nil
+	}
+	r.send(p, Event{Type: "state", PlayerID: p.ID, Player: p.Name, Room: r.snapshot(p)})
+}
+
+// remove gives up p's seat for good. The caller must hold r.mu.
+func (r *Room) remove(p *Player) {
+	p.left = true
+	r.broadcast(Event{Type: "left", Player: p.Name}, p)
+
+	switch r.state {
+	case StateWaiting:
+		// Hand the room over so it can still be started
+		if r.host == p.Name {
+			r.host = ""
+			for _, other := range r.order {
+				if !other.left {
+					r.host = other.Name
+					break
+				}
+			}
+		}
+	case StatePlaying:
+		if r.stillPlaying() == 0 {
+			r.finish(ReasonExhausted)
+		}
+	}
+}
+
+// finish ends the race and reveals the target. The caller must hold r.mu.
+func (r *Room) finish(reason string) {
+	r.state = StateFinished
+	if r.timer != nil {
+		r.timer.Stop()
+	}
+	r.broadcast(Event{Type: "finished", Winner: r.winner, Reason: reason, Target: r.target}, nil)
+	r.scheduleIdle()
+}
+
+// scheduleIdle closes the room after the lobby's IdleTimeout once it is
+// finished or nobody is connected. The caller must hold r.mu.
+func (r *Room) scheduleIdle() {
+	if r.idle != nil {
+		return
+	}
+	if r.state != StateFinished {
+		for _, p := range r.order {
+			if p.connected {
+				return
+			}
+		}
+	}
+
+	r.idle = time.AfterFunc(r.lobby.IdleTimeout, func() {
+		r.lobby.mu.Lock()
+		delete(r.lobby.rooms, r.Code)
+		r.lobby.mu.Unlock()
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.timer != nil {
+			r.timer.Stop()
+		}
+		for _, p := range r.order {
+			if p.grace != nil {
+				p.grace.Stop()
+			}
+			if p.connected {
+				p.connected = false
+				close(p.events)
+			}
+		}
+	})
+}
+
+// broadcast sends e to every connected player except skip.
+// The caller must hold r.mu.
+func (r *Room) broadcast(e Event, skip *Player) {
+	for _, p := range r.order {
+		if p != skip && p.connected {
+			r.send(p, e)
+		}
+	}
+}
+
+// send queues e for p without blocking. A player whose buffer is full is
+// disconnected so one slow client cannot stall the room; they can rejoin and
+// will be sent a fresh state. The caller must hold r.mu.
+func (r *Room) send(p *Player, e Event) {
+	select {
+	case p.events <- e:
+	default:
+		p.connected = false
+		close(p.events)
+	}
+}
+
+// snapshot builds the room state as p sees it. The caller must hold r.mu.
+func (r *Room) snapshot(p *Player) *RoomState {
+	s := &RoomState{
+		Code:        r.Code,
+		Lang:        r.opts.Lang,
+		Size:        r.opts.Size,
+		MaxAttempts: r.opts.MaxAttempts,
+		State:       r.state,
+		Host:        r.host,
+		Players:     make([]PlayerState, 0, len(r.order)),
+	}
+	if r.state != StateWaiting {
+		deadline := r.deadline
+		s.Deadline = &deadline
+	}
+
+	for _, player := range r.order {
+		ps := PlayerState{
+			Name:      player.Name,
+			Connected: player.connected,
+			Status:    game.StatusInProgress,
+			Rows:      [][]string{},
+		}
+		if player.game != nil {
+			ps.Status = player.game.Status
+			for _, guess := range player.game.Guesses {
+				ps.Rows = append(ps.Rows, game.Colours(guess.Feedback))
+			}
+		}
+		if player.left {
+			ps.Status = "left"
+		}
+		s.Players = append(s.Players, ps)
+	}
+
+	if p != nil && p.game != nil {
+		for _, guess := range p.game.Guesses {
+			s.Guesses = append(s.Guesses, response.GuessRecord{Guess: guess.Word, Feedback: guess.Feedback})
+		}
+	}
+	return s
+}
+
+func (r *Room) activePlayers() int {
+	n := 0
+	for _, p := range r.order {
+		if !p.left {
+			n++
+		}
+	}
+	return n
+}
+
+// stillPlaying counts players who can still make a guess.
+func (r *Room) stillPlaying() int {
+	n := 0
+	for _, p := range r.order {
+		if !p.left && p.game != nil && !game.Finished(p.game) {
+			n++
+		}
+	}
+	return n
+}
+
+func newCode(n int) (string, error) {
+	b := make([]byte, n/2)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,190 @@
+package race
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// next returns the next event of the given type, skipping any others.
+func next(t *testing.T, events <-chan Event, typ string) Event {
+	t.Helper()
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				t.Fatalf("events closed while waiting for %q", typ)
+			}
+			if e.Type == typ {
+				return e
+			}
+		case <-timeout:
+			t.Fatalf("timed out waiting for %q", typ)
+		}
+	}
+}
+
+func newRoom(t *testing.T, lobby *Lobby, opts Options) *Room {
+	t.Helper()
+	r, err := lobby.Create(opts)
+	if err != nil {
+		t.Fatalf("Create() returned error: %v", err)
+	}
+	// Fix the target so the test can play to a known result
+	r.target = "brick"
+	return r
+}
+
+func TestRace(t *testing.T) {
+	lobby := NewLobby()
+	r := newRoom(t, lobby, Options{})
+
+	alice, err := r.Join("alice")
+	if err != nil {
+		t.Fatalf("Join() returned error: %v", err)
+	}
+	aliceEvents := alice.Events()
+	if e := next(t, aliceEvents, "state"); e.PlayerID != alice.ID || e.Room.Host != "alice" {
+		t.Errorf("state event = %+v; want alice's ID and alice hosting", e)
+	}
+
+	if err := r.Start(alice); !errors.Is(err, ErrNotEnoughPlayers) {
+		t.Errorf("Start() alone error = %v; want %v", err, ErrNotEnoughPlayers)
+	}
+	if _, err := r.Join("alice"); !errors.Is(err, ErrNameTaken) {
+		t.Errorf("Join() duplicate name error = %v; want %v", err, ErrNameTaken)
+	}
+
+	bob, _ := r.Join("bob")
+	bobEvents := bob.Events()
+	next(t, aliceEvents, "joined")
+
+	if err := r.Start(bob); !errors.Is(err, ErrNotHost) {
+		t.Errorf("Start() by guest error = %v; want %v", err, ErrNotHost)
+	}
+	if err := r.Guess(alice, "crate"); !errors.Is(err, ErrNotStarted) {
+		t.Errorf("Guess() before start error = %v; want %v", err, ErrNotStarted)
+	}
+	if err := r.Start(alice); err != nil {
+		t.Fatalf("Start() returned error: %v", err)
+	}
+	next(t, bobEvents, "started")
+	if _, err := r.Join("carol"); !errors.Is(err, ErrRoomStarted) {
+		t.Errorf("Join() after start error = %v; want %v", err, ErrRoomStarted)
+	}
+
+	if err := r.Guess(alice, "crate"); err != nil {
+		t.Fatalf("Guess() returned error: %v", err)
+	}
+	own := next(t, aliceEvents, "guess")
+	if own.Feedback[0].Letter != "c" {
+		t.Errorf("own guess feedback = %+v; want letters", own.Feedback)
+	}
+	progress := next(t, bobEvents, "progress")
+	if progress.Player != "alice" || len(progress.Row) != 5 || progress.Feedback != nil {
+		t.Errorf("progress event = %+v; want alice's colours without letters", progress)
+	}
+
+	// Bob drops out and comes back with his player ID
+	r.Disconnect(bob, bobEvents)
+	next(t, aliceEvents, "disconnected")
+	bob, err = r.Rejoin(bob.ID)
+	if err != nil {
+		t.Fatalf("Rejoin() returned error: %v", err)
+	}
+	bobEvents = bob.Events()
+	state := next(t, bobEvents, "state")
+	if state.Room.State != StatePlaying || len(state.Room.Players[0].Rows) != 1 {
+		t.Errorf("state after rejoin = %+v; want the race in progress with alice's row", state.Room)
+	}
+	next(t, aliceEvents, "reconnected")
+
+	if err := r.Guess(bob, "brick"); err != nil {
+		t.Fatalf("Guess() returned error: %v", err)
+	}
+	finished := next(t, aliceEvents, "finished")
+	if finished.Winner != "bob" || finished.Reason != ReasonSolved || finished.Target != "brick" {
+		t.Errorf("finished event = %+v; want bob solving brick", finished)
+	}
+	if err := r.Guess(alice, "brick"); !errors.Is(err, ErrRoomFinished) {
+		t.Errorf("Guess() after finish error = %v; want %v", err, ErrRoomFinished)
+	}
+}
+
+func TestRaceTimeouts(t *testing.T) {
+	lobby := NewLobby()
+	lobby.ReconnectGrace = 10 * time.Millisecond
+	lobby.IdleTimeout = 20 * time.Millisecond
+
+	t.Run("Time limit", func(t *testing.T) {
+		r := newRoom(t, lobby, Options{TimeLimit: 20 * time.Millisecond})
+		alice, _ := r.Join("alice")
+		r.Join("bob")
+		if err := r.Start(alice); err != nil {
+			t.Fatalf("Start() returned error: %v", err)
+		}
+		if e := next(t, alice.Events(), "finished"); e.Reason != ReasonTimeout || e.Winner != "" {
+			t.Errorf("finished event = %+v; want a timeout without winner", e)
+		}
+	})
+
+	t.Run("Abandoned race", func(t *testing.T) {
+		r := newRoom(t, lobby, Options{})
+		alice, _ := r.Join("alice")
+		bob, _ := r.Join("bob")
+		r.Start(alice)
+
+		// Bob never comes back, so alice is the last one playing; when she
+		// runs out of attempts the race is over
+		r.Disconnect(bob, bob.Events())
+		next(t, alice.Events(), "left")
+		for i := 0; i < 6; i++ {
+			if err := r.Guess(alice, "crate"); err != nil {
+				t.Fatalf("Guess() returned error: %v", err)
+			}
+		}
+		if e := next(t, alice.Events(), "finished"); e.Reason != ReasonExhausted {
+			t.Errorf("finished event = %+v; want %q", e, ReasonExhausted)
+		}
+		if _, err := r.Rejoin(bob.ID); !errors.Is(err, ErrUnknownPlayer) {
+			t.Errorf("Rejoin() after grace error = %v; want %v", err, ErrUnknownPlayer)
+		}
+	})
+
+	t.Run("Idle room closes", func(t *testing.T) {
+		r := newRoom(t, lobby, Options{})
+		alice, _ := r.Join("alice")
+		r.Disconnect(alice, alice.Events())
+
+		deadline := time.Now().Add(time.Second)
+		for time.Now().Before(deadline) {
+			if _, err := lobby.Room(r.Code); errors.Is(err, ErrRoomNotFound) {
+				return
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+		t.Errorf("idle room %s was not closed", r.Code)
+	})
+}
+
+func TestSlowPlayerIsDropped(t *testing.T) {
+	r := newRoom(t, NewLobby(), Options{})
+	alice, _ := r.Join("alice")
+	bob, _ := r.Join("bob")
+	r.Start(alice)
+
+	// Bob never reads; alice's guesses must not block once his buffer is full
+	for i := 0; i < eventBuffer+2; i++ {
+		r.mu.Lock()
+		r.broadcast(Event{Type: "progress", Player: "alice"}, alice)
+		r.mu.Unlock()
+	}
+
+	events := bob.Events()
+	for range events {
+	}
+	if state := r.State(alice); state.Players[1].Connected {
+		t.Errorf("slow player is still connected")
+	}
+}
@@ -1,8 +1,22 @@
 package response
 
+import "time"
+
 // BodyWordsegPost represents the request body for the /wordseg endpoint
 type BodyWordsegPost struct {
 	Text string `json:"text" validate:"required"`
+	Lang string `json:"lang" validate:"omitempty"`
+}
+
+// WordSubmission represents a custom word awaiting or past moderation
+type WordSubmission struct {
+	ID         string     `json:"id"`
+	Lang       string     `json:"lang"`
+	Word       string     `json:"word"`
+	Status     string     `json:"status"` // Values: "pending", "approved", "rejected"
+	UserID     string     `json:"user_id,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
 }
 
 // GuessResult represents the structure of a guess result
@@ -19,17 +33,367 @@ type ValidationError struct {
 	Type string   `json:"type"`
 }
 
-// HTTPValidationError represents the structure of a validation error response
-type HTTPValidationError struct {
-	Detail []ValidationError `json:"detail"`
+// Error is the body of every error response
+type Error struct {
+	Code      string            `json:"code"`                 // Machine-readable, one of ErrorCodes
+	Message   string            `json:"message"`              // Human-readable, may change between releases
+	Details   []ValidationError `json:"details,omitempty"`    // The offending fields of validation_failed and hard_mode_violation errors
+	RequestID string            `json:"request_id,omitempty"` // Also sent in the X-Request-ID header
+}
+
+// Error codes. Clients should branch on these rather than on messages or
+// only on HTTP statuses, which several codes share.
+const (
+	CodeBadRequest          = "bad_request"          // 400: the request cannot be served as sent
+	CodeInvalidJSON         = "invalid_json"         // 400: the body is not valid JSON
+	CodeInvalidQuery        = "invalid_query"        // 400: the query parameters cannot be parsed
+	CodeUnsupportedLanguage = "unsupported_language" // 400: lang names no loaded language
+	CodeInvalidWord         = "invalid_word"         // 400: the word is not in the dictionary or uses foreign letters
+	CodeWrongLength         = "wrong_length"         // 400: the guess does not fit the puzzle size
+	CodeNoWordsOfSize       = "no_words_of_size"     // 400: no puzzle can be made with the requested size
+	CodeInvalidPuzzleToken  = "invalid_puzzle_token" // 400: the puzzle token was not issued by this server
+	CodeUnauthorized        = "unauthorized"         // 401: missing, invalid or expired credentials
+	CodeForbidden           = "forbidden"            // 403: the caller may not access the resource
+	CodeSeedForbidden       = "seed_forbidden"       // 403: raw seeds are limited to debug mode and admins
+	CodeNotFound            = "not_found"            // 404: no such route or resource
+	CodeMethodNotAllowed    = "method_not_allowed"   // 405: the route does not accept the method
+	CodeConflict            = "conflict"             // 409: the resource changed concurrently or is in the wrong state
+	CodeGameOver            = "game_over"            // 409: the game has already finished
+	CodeWordExists          = "word_exists"          // 409: the word is already in the dictionary
+	CodeValidationFailed    = "validation_failed"    // 422: fields failed validation, see details
+	CodeHardModeViolation   = "hard_mode_violation"  // 422: the guess ignores revealed hints, see details
+	CodeUpgradeRequired     = "upgrade_required"     // 426: the route only accepts WebSocket connections
+	CodeInternal            = "internal_error"       // 500: the server failed; retrying may help
+)
+
+// ErrorCodes lists every error code.
+var ErrorCodes = []string{
+	CodeBadRequest, CodeInvalidJSON, CodeInvalidQuery, CodeUnsupportedLanguage, CodeInvalidWord,
+	CodeWrongLength, CodeNoWordsOfSize, CodeInvalidPuzzleToken, CodeUnauthorized, CodeForbidden,
+	CodeSeedForbidden, CodeNotFound, CodeMethodNotAllowed, CodeConflict, CodeGameOver, CodeWordExists,
+	CodeValidationFailed, CodeHardModeViolation, CodeUpgradeRequired, CodeInternal,
 }
 
 type LetterFeedback struct {
-	Letter string `json:"letter"`
-	Status string `json:"status"` // "correct", "present", "absent"
+	Letter string `json:"letter" validate:"required"`
+	Status string `json:"status" validate:"oneof=correct present absent"` // "correct", "present", "absent"
 }
 
 type GuessResponse struct {
 	Feedback []LetterFeedback `json:"feedback"`
 	Message  string           `json:"message"`
 }
+
+// BodyGamePost represents the request body for the POST /games endpoint
+type BodyGamePost struct {
+	Mode        string `json:"mode" validate:"omitempty,oneof=classic dordle quordle octordle absurdle"`
+	Lang        string `json:"lang" validate:"omitempty"`
+	Size        int    `json:"size" validate:"omitempty,min=3,max=15"`
+	Seed        int64  `json:"seed" validate:"omitempty,excluded_with=Daily"` // Debug and admin only
+	MaxAttempts int    `json:"max_attempts" validate:"omitempty,min=1,max=20"`
+	HardMode    bool   `json:"hard_mode"`
+	Daily       bool   `json:"daily"`                                                // Play today's daily puzzle
+	Puzzle      string `json:"puzzle" validate:"omitempty,excluded_with=Seed Daily"` // Replay the puzzle behind a puzzle_token
+}
+
+// BodyGuessPost represents the request body for the POST /games/:id/guesses endpoint
+type BodyGuessPost struct {
+	Guess string `json:"guess" validate:"required"`
+}
+
+// GuessRecord is a single entry in a game's guess history
+type GuessRecord struct {
+	Guess    string             `json:"guess" validate:"required"`
+	Feedback []LetterFeedback   `json:"feedback" validate:"required,dive"`
+	Boards   [][]LetterFeedback `json:"boards,omitempty"` // Multi-board games only; null for boards solved earlier
+}
+
+// GameState represents the public view of a game; Target is only set once the game ends
+type GameState struct {
+	ID                string        `json:"id"`
+	Mode              string        `json:"mode"`
+	Lang              string        `json:"lang"`
+	Size              int           `json:"size"`
+	MaxAttempts       int           `json:"max_attempts"`
+	HardMode          bool          `json:"hard_mode"`
+	PuzzleNumber      int           `json:"puzzle_number,omitempty"`
+	PuzzleToken       string        `json:"puzzle_token,omitempty"` // Opaque reference to the target for sharing and replays
+	Status            string        `json:"status"`                 // Values: "in_progress", "won", "lost"
+	RemainingAttempts int           `json:"remaining_attempts"`
+	Guesses           []GuessRecord `json:"guesses"`
+	Target            string        `json:"target,omitempty"`
+	Candidates        int           `json:"candidates,omitempty"` // Absurdle games: answers still possible
+	Boards            []BoardState  `json:"boards,omitempty"`     // Multi-board games only
+	Keyboard          []KeyState    `json:"keyboard"`
+}
+
+// BoardState is the progress on one board of a multi-board game; Target is only set once the game ends
+type BoardState struct {
+	Board    int    `json:"board"`
+	Solved   bool   `json:"solved"`
+	SolvedIn int    `json:"solved_in,omitempty"` // Guesses it took to solve the board
+	Target   string `json:"target,omitempty"`
+}
+
+// KeyState is the best known status of a letter; Boards splits it per board in multi-board games
+type KeyState struct {
+	Letter string   `json:"letter"`
+	Status string   `json:"status"` // Values: "correct", "present", "absent", "unused"
+	Boards []string `json:"boards,omitempty"`
+}
+
+// Keyboard lists every letter of a game's alphabet in keyboard order with its best known status
+type Keyboard struct {
+	GameID string     `json:"game_id"`
+	Lang   string     `json:"lang"`
+	Keys   []KeyState `json:"keys"`
+}
+
+// BoardFeedback is the outcome of a guess on one board of a multi-board game
+type BoardFeedback struct {
+	Board    int              `json:"board"`
+	Feedback []LetterFeedback `json:"feedback"`
+	Solved   bool             `json:"solved"`
+}
+
+// GameGuessResponse represents the outcome of submitting a guess to a game
+type GameGuessResponse struct {
+	GameID            string           `json:"game_id"`
+	Feedback          []LetterFeedback `json:"feedback"`
+	Status            string           `json:"status"`
+	RemainingAttempts int              `json:"remaining_attempts"`
+	Target            string           `json:"target,omitempty"`
+	Boards            []BoardFeedback  `json:"boards,omitempty"`  // Multi-board games: one entry per board unsolved before the guess
+	Targets           []string         `json:"targets,omitempty"` // Multi-board games, once finished
+	Keyboard          []KeyState       `json:"keyboard"`
+}
+
+// DailyInfo describes the active daily puzzle without revealing its answer
+type DailyInfo struct {
+	PuzzleNumber     int       `json:"puzzle_number"`
+	Title            string    `json:"title"`
+	Date             string    `json:"date"`
+	Timezone         string    `json:"timezone"`
+	NextRolloverAt   time.Time `json:"next_rollover_at"`
+	SecondsUntilNext int64     `json:"seconds_until_next"`
+}
+
+// Health statuses
+const (
+	HealthOK          = "ok"
+	HealthUnavailable = "unavailable"
+)
+
+// Health is the body of the /healthz and /readyz probes
+type Health struct {
+	Status       string             `json:"status"` // Values: "ok", "unavailable"
+	Build        BuildInfo          `json:"build"`
+	Database     *DatabaseHealth    `json:"database,omitempty"`     // Only checked by /readyz
+	StoredWords  *StoredWordsHealth `json:"stored_words,omitempty"` // Only checked by /readyz
+	Dictionaries []DictionaryHealth `json:"dictionaries,omitempty"` // Only checked by /readyz
+}
+
+// BuildInfo identifies the running binary. VCS fields are empty when the
+// binary was built outside a git checkout.
+type BuildInfo struct {
+	Version   string `json:"version"`
+	Revision  string `json:"revision,omitempty"`
+	Time      string `json:"time,omitempty"`
+	Modified  bool   `json:"modified,omitempty"` // Built with uncommitted changes
+	GoVersion string `json:"go_version"`
+}
+
+// DatabaseHealth is the result of pinging the database
+type DatabaseHealth struct {
+	Status    string  `json:"status"` // Values: "ok", "unavailable"
+	LatencyMS float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"` // A fixed summary; the cause is only logged
+}
+
+// StoredWordsHealth reports whether the approved submissions and tier
+// overrides kept in the database have been merged into the word lists
+type StoredWordsHealth struct {
+	Status string `json:"status"`          // Values: "ok", "unavailable"
+	Error  string `json:"error,omitempty"` // A fixed summary; the cause is only logged
+}
+
+// DictionaryHealth reports the loaded word lists of one language. The maps
+// are keyed by word length.
+type DictionaryHealth struct {
+	Status  string      `json:"status"` // Values: "ok", "unavailable"
+	Lang    string      `json:"lang"`
+	Words   map[int]int `json:"words"`
+	Answers map[int]int `json:"answers"`
+}
+
+// BodySolverPost represents the request body for the /solver endpoints
+type BodySolverPost struct {
+	Lang     string        `json:"lang" validate:"omitempty"`
+	Size     int           `json:"size" validate:"omitempty,min=3,max=15"`
+	History  []GuessRecord `json:"history" validate:"dive"`
+	Strategy string        `json:"strategy" validate:"omitempty,oneof=entropy minimax"`
+	Limit    int           `json:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+// SolverCandidates lists the words still consistent with a guess history
+type SolverCandidates struct {
+	Count      int      `json:"count"`
+	Candidates []string `json:"candidates"`
+}
+
+// SolverSuggestion is a ranked next guess
+type SolverSuggestion struct {
+	Word      string  `json:"word"`
+	Score     float64 `json:"score"`
+	Candidate bool    `json:"candidate"`
+}
+
+// SolverSuggestions lists the best next guesses for a guess history
+type SolverSuggestions struct {
+	Strategy    string             `json:"strategy"`
+	Count       int                `json:"count"` // Number of remaining candidates
+	Suggestions []SolverSuggestion `json:"suggestions"`
+}
+
+// GameHint is a hint for an in-progress game
+type GameHint struct {
+	Type       string `json:"type"`               // Values: "letter", "candidates"
+	Position   int    `json:"position,omitempty"` // 1-based position of the revealed letter
+	Letter     string `json:"letter,omitempty"`
+	Remaining  int    `json:"remaining"` // Number of words still consistent with the guesses
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+// BodyWordTierPut represents the request body for the PUT /admin/words/:word/tier endpoint
+type BodyWordTierPut struct {
+	Lang string `json:"lang" validate:"omitempty"`
+	Tier string `json:"tier" validate:"required,oneof=answer guess"`
+}
+
+// WordTier reports whether a word can be picked as an answer or only guessed
+type WordTier struct {
+	Lang string `json:"lang"`
+	Word string `json:"word"`
+	Tier string `json:"tier"` // Values: "answer", "guess"
+}
+
+// BodyAuthPost represents the request body for the /auth/register and /auth/login endpoints
+type BodyAuthPost struct {
+	Username string `json:"username" validate:"required,min=3,max=32,alphanum"`
+	Password string `json:"password" validate:"required,min=8,max=72,maxbytes=72"` // bcrypt refuses more than 72 bytes
+}
+
+// UserProfile represents the public view of a user
+type UserProfile struct {
+	ID        string    `json:"id"`
+	Username  string    `json:"username"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AuthToken represents a session token returned on register and login
+type AuthToken struct {
+	Token     string      `json:"token"`
+	ExpiresAt time.Time   `json:"expires_at"`
+	User      UserProfile `json:"user"`
+}
+
+// UserStats represents a player's statistics for the /users/me/stats endpoint
+type UserStats struct {
+	GamesPlayed       int   `json:"games_played"`
+	Wins              int   `json:"wins"`
+	WinPercentage     int   `json:"win_percentage"`
+	CurrentStreak     int   `json:"current_streak"`
+	MaxStreak         int   `json:"max_streak"`
+	GuessDistribution []int `json:"guess_distribution"` // GuessDistribution[i] counts wins in i+1 guesses
+}
+
+// ShareText represents a rendered result grid for the /games/:id/share endpoint
+type ShareText struct {
+	Style string `json:"style"`
+	Text  string `json:"text"`
+}
+
+// BodySharePost represents the request body for the /share/parse endpoint
+type BodySharePost struct {
+	Text   string `json:"text" validate:"required"`
+	GameID string `json:"game_id" validate:"omitempty"` // Verify the grid against this game
+}
+
+// SharedResult represents a pasted result grid read back into feedback rows.
+// Letters are never part of a share, so only the statuses are filled in.
+type SharedResult struct {
+	Title        string             `json:"title"`
+	PuzzleNumber int                `json:"puzzle_number,omitempty"`
+	MaxAttempts  int                `json:"max_attempts"`
+	Won          bool               `json:"won"`
+	HardMode     bool               `json:"hard_mode"`
+	Rows         [][]LetterFeedback `json:"rows"`
+	Verified     *bool              `json:"verified,omitempty"` // Set when game_id was given
+}
+
+// LeaderboardEntry represents one ranked player on a leaderboard
+type LeaderboardEntry struct {
+	Rank          int     `json:"rank"`
+	UserID        string  `json:"user_id"`
+	Username      string  `json:"username"`
+	Guesses       int     `json:"guesses,omitempty"`
+	SolveSeconds  float64 `json:"solve_seconds,omitempty"`
+	GamesPlayed   int     `json:"games_played,omitempty"`
+	Wins          int     `json:"wins,omitempty"`
+	WinPercentage int     `json:"win_percentage,omitempty"`
+	MaxStreak     int     `json:"max_streak,omitempty"`
+}
+
+// Leaderboard represents one page of a ranked leaderboard
+type Leaderboard struct {
+	Board        string             `json:"board"` // Values: "daily", "win_rate", "streak"
+	PuzzleNumber int                `json:"puzzle_number,omitempty"`
+	Page         int                `json:"page"`
+	PerPage      int                `json:"per_page"`
+	Entries      []LeaderboardEntry `json:"entries"`
+}
+
+// BodyChallengePost represents the request body for the POST /challenges endpoint
+type BodyChallengePost struct {
+	Lang        string `json:"lang" validate:"omitempty"`
+	Word        string `json:"word" validate:"required"`
+	Custom      bool   `json:"custom"` // Allow a word outside the dictionary
+	MaxAttempts int    `json:"max_attempts" validate:"omitempty,min=1,max=20"`
+}
+
+// BodyChallengeGuessPost represents the request body for the /challenges/:code/guesses endpoint
+type BodyChallengeGuessPost struct {
+	Guess  string `json:"guess" validate:"required"`
+	GameID string `json:"game_id" validate:"omitempty"` // Continue a game started by an earlier guess
+}
+
+// ChallengeInfo describes a challenge without revealing its word
+type ChallengeInfo struct {
+	Code        string    `json:"code"`
+	Lang        string    `json:"lang"`
+	Size        int       `json:"size"`
+	MaxAttempts int       `json:"max_attempts"`
+	Custom      bool      `json:"custom"`
+	Attempts    int       `json:"attempts"`
+	Solves      int       `json:"solves"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// BodyRacePost represents the request body for the POST /race/rooms endpoint
+type BodyRacePost struct {
+	Lang             string `json:"lang" validate:"omitempty"`
+	Size             int    `json:"size" validate:"omitempty,min=3,max=15"`
+	MaxAttempts      int    `json:"max_attempts" validate:"omitempty,min=1,max=20"`
+	MaxPlayers       int    `json:"max_players" validate:"omitempty,min=2,max=32"`
+	TimeLimitSeconds int    `json:"time_limit_seconds" validate:"omitempty,min=30,max=3600"`
+}
+
+// RaceRoom describes a newly opened race room
+type RaceRoom struct {
+	Code        string `json:"code"`
+	Lang        string `json:"lang"`
+	Size        int    `json:"size"`
+	MaxAttempts int    `json:"max_attempts"`
+	// Socket is the WebSocket path players connect to with ?name=
+	Socket string `json:"socket"`
+}
@@ -0,0 +1,96 @@
+package rpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+
+	"Wordle/internal/auth"
+	"Wordle/internal/database"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type contextKey int
+
+const (
+	userKey contextKey = iota
+	seedsKey
+)
+
+// Authenticator resolves the caller of every call from its metadata, the
+// same way the REST middlewares do from request headers: a bearer token in
+// "authorization" identifies the player, and "x-admin-token" or debug mode
+// unlock raw seeds.
+type Authenticator struct {
+	db         database.Service
+	tokens     *auth.Tokens
+	adminToken string
+	debug      bool
+}
+
+func NewAuthenticator(db database.Service, tokens *auth.Tokens, adminToken string, debug bool) *Authenticator {
+	return &Authenticator{
+		db:         db,
+		tokens:     tokens,
+		adminToken: adminToken,
+		debug:      debug,
+	}
+}
+
+// Intercept is a grpc.UnaryServerInterceptor. Calls without a token stay
+// anonymous; calls with an invalid one are rejected.
+func (a *Authenticator) Intercept(ctx context.Context, req any, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (any, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	admin := first(md, "x-admin-token")
+	seeds := a.debug || (a.adminToken != "" && subtle.ConstantTimeCompare([]byte(admin), []byte(a.adminToken)) == 1)
+	ctx = context.WithValue(ctx, seedsKey, seeds)
+
+	header := first(md, "authorization")
+	if header == "" {
+		return next(ctx, req)
+	}
+
+	token, found := strings.CutPrefix(header, "Bearer ")
+	if !found || a.tokens == nil {
+		return nil, status.Error(codes.Unauthenticated, "authorization must be a Bearer token")
+	}
+	userID, err := a.tokens.Verify(token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	user, err := a.db.LookupUser(ctx, userID)
+	if errors.Is(err, database.ErrNotFound) {
+		return nil, status.Error(codes.Unauthenticated, "user no longer exists")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load user")
+	}
+
+	return next(context.WithValue(ctx, userKey, user.ID), req)
+}
+
+func first(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// userID returns the authenticated player of the call, or "" when anonymous.
+func userID(ctx context.Context) string {
+	id, _ := ctx.Value(userKey).(string)
+	return id
+}
+
+// seedsAllowed reports whether the call may pick a target with a raw seed.
+func seedsAllowed(ctx context.Context) bool {
+	allowed, _ := ctx.Value(seedsKey).(bool)
+	return allowed
+}
@@ -0,0 +1,83 @@
+package rpc
+
+import (
+	"Wordle/internal/response"
+	"Wordle/internal/rpc/wordlev1"
+)
+
+func letterFeedback(feedback []response.LetterFeedback) []*wordlev1.LetterFeedback {
+	out := make([]*wordlev1.LetterFeedback, len(feedback))
+	for i, fb := range feedback {
+		out[i] = &wordlev1.LetterFeedback{Letter: fb.Letter, Status: fb.Status}
+	}
+	return out
+}
+
+func keyStates(keys []response.KeyState) []*wordlev1.KeyState {
+	out := make([]*wordlev1.KeyState, len(keys))
+	for i, k := range keys {
+		out[i] = &wordlev1.KeyState{Letter: k.Letter, Status: k.Status, Boards: k.Boards}
+	}
+	return out
+}
+
+func boardFeedback(b response.BoardFeedback) *wordlev1.BoardFeedback {
+	return &wordlev1.BoardFeedback{
+		Board:    int32(b.Board),
+		Feedback: letterFeedback(b.Feedback),
+		Solved:   b.Solved,
+	}
+}
+
+func gameMessage(state response.GameState) *wordlev1.Game {
+	g := &wordlev1.Game{
+		Id:                state.ID,
+		Mode:              state.Mode,
+		Lang:              state.Lang,
+		Size:              int32(state.Size),
+		MaxAttempts:       int32(state.MaxAttempts),
+		HardMode:          state.HardMode,
+		PuzzleNumber:      int32(state.PuzzleNumber),
+		PuzzleToken:       state.PuzzleToken,
+		Status:            state.Status,
+		RemainingAttempts: int32(state.RemainingAttempts),
+		Target:            state.Target,
+		Candidates:        int32(state.Candidates),
+		Keyboard:          keyStates(state.Keyboard),
+	}
+	for _, guess := range state.Guesses {
+		record := &wordlev1.GuessRecord{
+			Guess:    guess.Guess,
+			Feedback: letterFeedback(guess.Feedback),
+		}
+		// Boards solved before the guess have no feedback and are left out
+		for board, fb := range guess.Boards {
+			if fb != nil {
+				record.Boards = append(record.Boards, &wordlev1.BoardFeedback{
+					Board:    int32(board),
+					Feedback: letterFeedback(fb),
+					Solved:   allCorrect(fb),
+				})
+			}
+		}
+		g.Guesses = append(g.Guesses, record)
+	}
+	for _, b := range state.Boards {
+		g.Boards = append(g.Boards, &wordlev1.BoardState{
+			Board:    int32(b.Board),
+			Solved:   b.Solved,
+			SolvedIn: int32(b.SolvedIn),
+			Target:   b.Target,
+		})
+	}
+	return g
+}
+
+func allCorrect(feedback []response.LetterFeedback) bool {
+	for _, fb := range feedback {
+		if fb.Status != "correct" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,235 @@
+// Package rpc serves the gRPC API. It only translates between the protobuf
+// messages and the session service the REST handlers use as well.
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"Wordle/internal/database"
+	"Wordle/internal/game"
+	"Wordle/internal/puzzle"
+	"Wordle/internal/response"
+	"Wordle/internal/rpc/wordlev1"
+	"Wordle/internal/session"
+	"Wordle/internal/utils"
+
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var validate = validator.New()
+
+// Server implements wordlev1.WordleServer.
+type Server struct {
+	wordlev1.UnimplementedWordleServer
+
+	sessions *session.Service
+}
+
+// New creates a gRPC server exposing the Wordle service. Callers authenticate
+// with the same bearer tokens and admin token as on the REST API.
+func New(sessions *session.Service, auth *Authenticator) *grpc.Server {
+	srv := grpc.NewServer(grpc.UnaryInterceptor(auth.Intercept))
+	wordlev1.RegisterWordleServer(srv, &Server{
+		sessions: sessions,
+	})
+	return srv
+}
+
+func (s *Server) ScoreGuess(ctx context.Context, req *wordlev1.ScoreGuessRequest) (*wordlev1.ScoreGuessResponse, error) {
+	switch {
+	case req.Guess == "":
+		return nil, status.Error(codes.InvalidArgument, "guess is required")
+	case req.Size != 0 && (req.Size < 3 || req.Size > 15):
+		return nil, status.Error(codes.InvalidArgument, "size must be between 3 and 15")
+	case req.Puzzle != "" && (req.Seed != 0 || req.Daily):
+		return nil, status.Error(codes.InvalidArgument, "puzzle cannot be combined with seed or daily")
+	}
+	if req.Seed != 0 && !seedsAllowed(ctx) {
+		return nil, errSeedForbidden
+	}
+
+	feedback, token, err := s.sessions.Score(session.Score{
+		Lang:   req.Lang,
+		Guess:  req.Guess,
+		Size:   int(req.Size),
+		Seed:   req.Seed,
+		Puzzle: req.Puzzle,
+		Daily:  req.Daily,
+	})
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &wordlev1.ScoreGuessResponse{
+		Feedback:    letterFeedback(feedback),
+		PuzzleToken: token,
+	}, nil
+}
+
+func (s *Server) StartGame(ctx context.Context, req *wordlev1.StartGameRequest) (*wordlev1.Game, error) {
+	// The REST request body carries the same rules, so both APIs accept
+	// exactly the same games
+	body := response.BodyGamePost{
+		Mode:        req.Mode,
+		Lang:        req.Lang,
+		Size:        int(req.Size),
+		Seed:        req.Seed,
+		MaxAttempts: int(req.MaxAttempts),
+		HardMode:    req.HardMode,
+		Daily:       req.Daily,
+		Puzzle:      req.Puzzle,
+	}
+	if err := validate.Struct(&body); err != nil {
+		return nil, validationError(err)
+	}
+	if req.Seed != 0 && !seedsAllowed(ctx) {
+		return nil, errSeedForbidden
+	}
+
+	g, err := s.sessions.Start(ctx, session.Start{
+		Options: game.Options{
+			Mode:        body.Mode,
+			Lang:        body.Lang,
+			Size:        body.Size,
+			Seed:        body.Seed,
+			MaxAttempts: body.MaxAttempts,
+			HardMode:    body.HardMode,
+		},
+		Daily:  body.Daily,
+		Puzzle: body.Puzzle,
+		UserID: userID(ctx),
+	})
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return gameMessage(session.State(g)), nil
+}
+
+func (s *Server) GetGame(ctx context.Context, req *wordlev1.GetGameRequest) (*wordlev1.Game, error) {
+	g, err := s.sessions.Game(ctx, req.Id, userID(ctx))
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return gameMessage(session.State(g)), nil
+}
+
+func (s *Server) SubmitGuess(ctx context.Context, req *wordlev1.SubmitGuessRequest) (*wordlev1.SubmitGuessResponse, error) {
+	if req.Guess == "" {
+		return nil, status.Error(codes.InvalidArgument, "guess is required")
+	}
+	g, err := s.sessions.Game(ctx, req.GameId, userID(ctx))
+	if err != nil {
+		return nil, statusError(err)
+	}
+	guess, err := s.sessions.Guess(ctx, g, req.Guess, false)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	result := session.GuessResult(g, guess)
+	resp := &wordlev1.SubmitGuessResponse{
+		GameId:            result.GameID,
+		Feedback:          letterFeedback(result.Feedback),
+		Status:            result.Status,
+		RemainingAttempts: int32(result.RemainingAttempts),
+		Target:            result.Target,
+		Targets:           result.Targets,
+		Keyboard:          keyStates(result.Keyboard),
+	}
+	for _, b := range result.Boards {
+		resp.Boards = append(resp.Boards, boardFeedback(b))
+	}
+	return resp, nil
+}
+
+func (s *Server) GetDailyInfo(ctx context.Context, req *wordlev1.GetDailyInfoRequest) (*wordlev1.DailyInfo, error) {
+	info := s.sessions.DailyInfo(time.Now())
+	return &wordlev1.DailyInfo{
+		PuzzleNumber:     int32(info.PuzzleNumber),
+		Title:            info.Title,
+		Date:             info.Date,
+		Timezone:         info.Timezone,
+		NextRolloverAt:   timestamppb.New(info.NextRolloverAt),
+		SecondsUntilNext: info.SecondsUntilNext,
+	}, nil
+}
+
+func (s *Server) ValidateWord(ctx context.Context, req *wordlev1.ValidateWordRequest) (*wordlev1.ValidateWordResponse, error) {
+	ws, err := utils.Lookup(req.Lang)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	word := ws.Normalize(req.Word)
+	return &wordlev1.ValidateWordResponse{
+		Valid:      ws.IsValidWord(word),
+		Normalized: word,
+	}, nil
+}
+
+var errSeedForbidden = status.Error(codes.PermissionDenied, "seed is only honoured in debug mode or for admins; use a puzzle token instead")
+
+// statusError maps a session error to the gRPC status closest to the one
+// the REST API answers with. Like the REST API it hides the message of any
+// error it does not know.
+func statusError(err error) error {
+	var hardModeErr *game.HardModeError
+	switch {
+	case errors.As(err, &hardModeErr):
+		return status.Error(codes.InvalidArgument, strings.Join(hardModeErr.Violations, "; "))
+	case errors.Is(err, database.ErrNotFound):
+		return status.Error(codes.NotFound, "game not found")
+	case errors.Is(err, session.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, game.ErrGameOver):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, database.ErrConflict):
+		return status.Error(codes.Aborted, "the game was updated concurrently, please retry")
+	}
+	for _, invalid := range invalidArguments {
+		if errors.Is(err, invalid) {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	// Server-side failures only report the sentinel, not the wrapped cause
+	for _, internal := range []error{session.ErrSave, session.ErrPuzzleToken, session.ErrNoTarget} {
+		if errors.Is(err, internal) {
+			return status.Error(codes.Internal, internal.Error())
+		}
+	}
+	return status.Error(codes.Internal, "internal server error")
+}
+
+// invalidArguments are the errors caused by the request itself.
+var invalidArguments = []error{
+	utils.ErrUnknownLanguage,
+	utils.ErrNoWordsOfSize,
+	utils.ErrNotEnoughWords,
+	utils.ErrEmptyWord,
+	utils.ErrForeignLetters,
+	game.ErrInvalidWord,
+	game.ErrWrongLength,
+	game.ErrUnknownMode,
+	game.ErrMultiUnsupported,
+	game.ErrAbsurdleUnsupported,
+	session.ErrWrongLength,
+	puzzle.ErrInvalidToken,
+}
+
+func validationError(err error) error {
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	msgs := make([]string, 0, len(errs))
+	for _, e := range errs {
+		msgs = append(msgs, fmt.Sprintf("%s failed the %q rule", e.Field(), e.Tag()))
+	}
+	return status.Error(codes.InvalidArgument, strings.Join(msgs, "; "))
+}
@@ -0,0 +1,235 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"Wordle/internal/auth"
+	"Wordle/internal/database"
+	"Wordle/internal/game"
+	"Wordle/internal/models"
+	"Wordle/internal/puzzle"
+	"Wordle/internal/rpc/wordlev1"
+	"Wordle/internal/session"
+	"Wordle/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// testServer serves the gRPC API over an in-memory connection and returns a
+// client for it along with its storage and token issuer.
+func testServer(t *testing.T, debug bool) (wordlev1.WordleClient, database.Service, *auth.Tokens) {
+	t.Helper()
+
+	daily, err := utils.NewDailySchedule("", "")
+	assert.NoError(t, err)
+	puzzles, err := puzzle.NewCodec([]byte("test-secret"))
+	assert.NoError(t, err)
+	db := database.NewMemory()
+	tokens := auth.NewTokens([]byte("test-secret"), auth.DefaultTokenTTL)
+
+	srv := New(session.New(db, daily, puzzles, nil), NewAuthenticator(db, tokens, "admin", debug))
+	lis := bufconn.Listen(1 << 20)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return wordlev1.NewWordleClient(conn), db, tokens
+}
+
+func TestGameFlow(t *testing.T) {
+	client, _, _ := testServer(t, true)
+	ctx := context.Background()
+
+	created, err := client.StartGame(ctx, &wordlev1.StartGameRequest{Seed: 1, MaxAttempts: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, "classic", created.Mode)
+	assert.Equal(t, "in_progress", created.Status)
+	assert.Equal(t, int32(2), created.RemainingAttempts)
+	assert.NotEmpty(t, created.PuzzleToken)
+	assert.Empty(t, created.Target)
+	assert.Len(t, created.Keyboard, 26)
+
+	_, err = client.SubmitGuess(ctx, &wordlev1.SubmitGuessRequest{GameId: created.Id, Guess: "zzzzz"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	var outcome *wordlev1.SubmitGuessResponse
+	for _, word := range []string{"cwtch", "cwtch"} {
+		outcome, err = client.SubmitGuess(ctx, &wordlev1.SubmitGuessRequest{GameId: created.Id, Guess: word})
+		assert.NoError(t, err)
+		assert.Len(t, outcome.Feedback, 5)
+	}
+	assert.Equal(t, "lost", outcome.Status)
+	assert.NotEmpty(t, outcome.Target)
+
+	_, err = client.SubmitGuess(ctx, &wordlev1.SubmitGuessRequest{GameId: created.Id, Guess: "cwtch"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	game, err := client.GetGame(ctx, &wordlev1.GetGameRequest{Id: created.Id})
+	assert.NoError(t, err)
+	assert.Len(t, game.Guesses, 2)
+	assert.Equal(t, outcome.Target, game.Target)
+
+	_, err = client.GetGame(ctx, &wordlev1.GetGameRequest{Id: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Replaying the puzzle token starts a game on the same target
+	replay, err := client.StartGame(ctx, &wordlev1.StartGameRequest{Puzzle: created.PuzzleToken})
+	assert.NoError(t, err)
+	won, err := client.SubmitGuess(ctx, &wordlev1.SubmitGuessRequest{GameId: replay.Id, Guess: outcome.Target})
+	assert.NoError(t, err)
+	assert.Equal(t, "won", won.Status)
+
+	_, err = client.StartGame(ctx, &wordlev1.StartGameRequest{Mode: "hexadecordle"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestMultiBoardGame(t *testing.T) {
+	client, _, _ := testServer(t, false)
+	ctx := context.Background()
+
+	created, err := client.StartGame(ctx, &wordlev1.StartGameRequest{Mode: "dordle"})
+	assert.NoError(t, err)
+	assert.Len(t, created.Boards, 2)
+	assert.Empty(t, created.PuzzleToken)
+
+	outcome, err := client.SubmitGuess(ctx, &wordlev1.SubmitGuessRequest{GameId: created.Id, Guess: "cwtch"})
+	assert.NoError(t, err)
+	assert.Len(t, outcome.Boards, 2)
+
+	game, err := client.GetGame(ctx, &wordlev1.GetGameRequest{Id: created.Id})
+	assert.NoError(t, err)
+	assert.Len(t, game.Guesses[0].Boards, 2)
+	for _, key := range game.Keyboard {
+		assert.Len(t, key.Boards, 2)
+	}
+}
+
+func TestScoreGuess(t *testing.T) {
+	client, _, _ := testServer(t, false)
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		req   *wordlev1.ScoreGuessRequest
+		code  codes.Code
+		token bool
+	}{
+		{name: "random", req: &wordlev1.ScoreGuessRequest{Guess: "apple"}, code: codes.OK, token: true},
+		{name: "daily", req: &wordlev1.ScoreGuessRequest{Guess: "apple", Daily: true}, code: codes.OK},
+		{name: "missing guess", req: &wordlev1.ScoreGuessRequest{}, code: codes.InvalidArgument},
+		{name: "wrong length", req: &wordlev1.ScoreGuessRequest{Guess: "apples"}, code: codes.InvalidArgument},
+		{name: "invalid word", req: &wordlev1.ScoreGuessRequest{Guess: "zzzzz"}, code: codes.InvalidArgument},
+		{name: "unknown language", req: &wordlev1.ScoreGuessRequest{Guess: "apple", Lang: "xx"}, code: codes.InvalidArgument},
+		{name: "seed without debug", req: &wordlev1.ScoreGuessRequest{Guess: "apple", Seed: 1}, code: codes.PermissionDenied},
+		{name: "bad puzzle token", req: &wordlev1.ScoreGuessRequest{Guess: "apple", Puzzle: "nope"}, code: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := client.ScoreGuess(ctx, tt.req)
+			assert.Equal(t, tt.code, status.Code(err))
+			if tt.code == codes.OK {
+				assert.Len(t, resp.Feedback, 5)
+				assert.Equal(t, tt.token, resp.PuzzleToken != "")
+			}
+		})
+	}
+
+	// The admin token unlocks seeds like the X-Admin-Token header does
+	admin := metadata.AppendToOutgoingContext(ctx, "x-admin-token", "admin")
+	_, err := client.ScoreGuess(admin, &wordlev1.ScoreGuessRequest{Guess: "apple", Seed: 1})
+	assert.NoError(t, err)
+}
+
+func TestOwnedGames(t *testing.T) {
+	client, db, tokens := testServer(t, false)
+	ctx := context.Background()
+
+	user := &models.User{Username: "alice"}
+	assert.NoError(t, db.CreateUser(ctx, user))
+	token, _, err := tokens.Issue(user.ID)
+	assert.NoError(t, err)
+	authed := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+
+	created, err := client.StartGame(authed, &wordlev1.StartGameRequest{})
+	assert.NoError(t, err)
+
+	_, err = client.GetGame(authed, &wordlev1.GetGameRequest{Id: created.Id})
+	assert.NoError(t, err)
+	_, err = client.GetGame(ctx, &wordlev1.GetGameRequest{Id: created.Id})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = client.SubmitGuess(ctx, &wordlev1.SubmitGuessRequest{GameId: created.Id, Guess: "apple"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	bad := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer nope")
+	_, err = client.GetDailyInfo(bad, &wordlev1.GetDailyInfoRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestDailyInfoAndValidateWord(t *testing.T) {
+	client, _, _ := testServer(t, false)
+	ctx := context.Background()
+
+	info, err := client.GetDailyInfo(ctx, &wordlev1.GetDailyInfoRequest{})
+	assert.NoError(t, err)
+	assert.NotZero(t, info.PuzzleNumber)
+	assert.Equal(t, "UTC", info.Timezone)
+	assert.True(t, info.NextRolloverAt.AsTime().After(time.Now()))
+
+	valid, err := client.ValidateWord(ctx, &wordlev1.ValidateWordRequest{Word: "APPLE"})
+	assert.NoError(t, err)
+	assert.True(t, valid.Valid)
+	assert.Equal(t, "apple", valid.Normalized)
+
+	valid, err = client.ValidateWord(ctx, &wordlev1.ValidateWordRequest{Word: "zzzzz"})
+	assert.NoError(t, err)
+	assert.False(t, valid.Valid)
+
+	_, err = client.ValidateWord(ctx, &wordlev1.ValidateWordRequest{Lang: "xx", Word: "apple"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestStatusError(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		code    codes.Code
+		message string
+	}{
+		{name: "invalid word", err: game.ErrInvalidWord, code: codes.InvalidArgument, message: game.ErrInvalidWord.Error()},
+		{name: "impossible size", err: fmt.Errorf("%w: %w", session.ErrNoTarget, utils.ErrNoWordsOfSize), code: codes.InvalidArgument},
+		{name: "storage failure", err: fmt.Errorf("%w: %w", session.ErrSave, errors.New("connection refused")), code: codes.Internal, message: session.ErrSave.Error()},
+		{name: "unknown failure", err: errors.New("connection refused"), code: codes.Internal, message: "internal server error"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(statusError(tt.err))
+			assert.Equal(t, tt.code, st.Code())
+			if tt.message != "" {
+				assert.Equal(t, tt.message, st.Message())
+			}
+		})
+	}
+}
@@ -0,0 +1,1670 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: wordle/v1/wordle.proto
+
+package wordlev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LetterFeedback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Letter string `protobuf:"bytes,1,opt,name=letter,proto3" json:"letter,omitempty"`
+	// One of "correct", "present", "absent"
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *LetterFeedback) Reset() {
+	*x = LetterFeedback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordle_v1_wordle_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LetterFeedback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LetterFeedback) ProtoMessage() {}
+
+func (x *LetterFeedback) ProtoReflect() protoreflect.Message {
+	mi := &file_wordle_v1_wordle_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LetterFeedback.ProtoReflect.Descriptor instead.
+func (*LetterFeedback) Descriptor() ([]byte, []int) {
+	return file_wordle_v1_wordle_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LetterFeedback) GetLetter() string {
+	if x != nil {
+		return x.Letter
+	}
+	return ""
+}
+
+func (x *LetterFeedback) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ScoreGuessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lang  string `protobuf:"bytes,1,opt,name=lang,proto3" json:"lang,omitempty"`
+	Guess string `protobuf:"bytes,2,opt,name=guess,proto3" json:"guess,omitempty"`
+	Size  int32  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// Debug and admin only
+	Seed int64 `protobuf:"varint,4,opt,name=seed,proto3" json:"seed,omitempty"`
+	// Replays the puzzle behind an earlier puzzle_token
+	Puzzle string `protobuf:"bytes,5,opt,name=puzzle,proto3" json:"puzzle,omitempty"`
+	// Scores against the daily word instead of a random one
+	Daily bool `protobuf:"varint,6,opt,name=daily,proto3" json:"daily,omitempty"`
+}
+
+func (x *ScoreGuessRequest) Reset() {
+	*x = ScoreGuessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordle_v1_wordle_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScoreGuessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreGuessRequest) ProtoMessage() {}
+
+func (x *ScoreGuessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordle_v1_wordle_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreGuessRequest.ProtoReflect.Descriptor instead.
+func (*ScoreGuessRequest) Descriptor() ([]byte, []int) {
+	return file_wordle_v1_wordle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScoreGuessRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+func (x *ScoreGuessRequest) GetGuess() string {
+	if x != nil {
+		return x.Guess
+	}
+	return ""
+}
+
+func (x *ScoreGuessRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ScoreGuessRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *ScoreGuessRequest) GetPuzzle() string {
+	if x != nil {
+		return x.Puzzle
+	}
+	return ""
+}
+
+func (x *ScoreGuessRequest) GetDaily() bool {
+	if x != nil {
+		return x.Daily
+	}
+	return false
+}
+
+type ScoreGuessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Feedback []*LetterFeedback `protobuf:"bytes,1,rep,name=feedback,proto3" json:"feedback,omitempty"`
+	// Replays the same target through ScoreGuessRequest.puzzle; unset for daily guesses
+	PuzzleToken string `protobuf:"bytes,2,opt,name=puzzle_token,json=puzzleToken,proto3" json:"puzzle_token,omitempty"`
+}
+
+func (x *ScoreGuessResponse) Reset() {
+	*x = ScoreGuessResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordle_v1_wordle_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScoreGuessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreGuessResponse) ProtoMessage() {}
+
+func (x *ScoreGuessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordle_v1_wordle_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreGuessResponse.ProtoReflect.Descriptor instead.
+func (*ScoreGuessResponse) Descriptor() ([]byte, []int) {
+	return file_wordle_v1_wordle_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ScoreGuessResponse) GetFeedback() []*LetterFeedback {
+	if x != nil {
+		return x.Feedback
+	}
+	return nil
+}
+
+func (x *ScoreGuessResponse) GetPuzzleToken() string {
+	if x != nil {
+		return x.PuzzleToken
+	}
+	return ""
+}
+
+type StartGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One of "classic", "dordle", "quordle", "octordle", "absurdle"
+	Mode string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	Lang string `protobuf:"bytes,2,opt,name=lang,proto3" json:"lang,omitempty"`
+	Size int32  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// Debug and admin only
+	Seed        int64 `protobuf:"varint,4,opt,name=seed,proto3" json:"seed,omitempty"`
+	MaxAttempts int32 `protobuf:"varint,5,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	HardMode    bool  `protobuf:"varint,6,opt,name=hard_mode,json=hardMode,proto3" json:"hard_mode,omitempty"`
+	// Play today's daily puzzle
+	Daily bool `protobuf:"varint,7,opt,name=daily,proto3" json:"daily,omitempty"`
+	// Replay the puzzle behind a puzzle_token
+	Puzzle string `protobuf:"bytes,8,opt,name=puzzle,proto3" json:"puzzle,omitempty"`
+}
+
+func (x *StartGameRequest) Reset() {
+	*x = StartGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordle_v1_wordle_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameRequest) ProtoMessage() {}
+
+func (x *StartGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordle_v1_wordle_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameRequest.ProtoReflect.Descriptor instead.
+func (*StartGameRequest) Descriptor() ([]byte, []int) {
+	return file_wordle_v1_wordle_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StartGameRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *StartGameRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+func (x *StartGameRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *StartGameRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *StartGameRequest) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *StartGameRequest) GetHardMode() bool {
+	if x != nil {
+		return x.HardMode
+	}
+	return false
+}
+
+func (x *StartGameRequest) GetDaily() bool {
+	if x != nil {
+		return x.Daily
+	}
+	return false
+}
+
+func (x *StartGameRequest) GetPuzzle() string {
+	if x != nil {
+		return x.Puzzle
+	}
+	return ""
+}
+
+type GetGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetGameRequest) Reset() {
+	*x = GetGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordle_v1_wordle_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameRequest) ProtoMessage() {}
+
+func (x *GetGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordle_v1_wordle_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameRequest.ProtoReflect.Descriptor instead.
+func (*GetGameRequest) Descriptor() ([]byte, []int) {
+	return file_wordle_v1_wordle_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetGameRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SubmitGuessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Guess  string `protobuf:"bytes,2,opt,name=guess,proto3" json:"guess,omitempty"`
+}
+
+func (x *SubmitGuessRequest) Reset() {
+	*x = SubmitGuessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordle_v1_wordle_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitGuessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitGuessRequest) ProtoMessage() {}
+
+func (x *SubmitGuessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordle_v1_wordle_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitGuessRequest.ProtoReflect.Descriptor instead.
+func (*SubmitGuessRequest) Descriptor() ([]byte, []int) {
+	return file_wordle_v1_wordle_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SubmitGuessRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SubmitGuessRequest) GetGuess() string {
+	if x != nil {
+		return x.Guess
+	}
+	return ""
+}
+
+type GuessRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Guess    string            `protobuf:"bytes,1,opt,name=guess,proto3" json:"guess,omitempty"`
+	Feedback []*LetterFeedback `protobuf:"bytes,2,rep,name=feedback,proto3" json:"feedback,omitempty"`
+	// Multi-board games only; empty for boards solved earlier
+	Boards []*BoardFeedback `protobuf:"bytes,3,rep,name=boards,proto3" json:"boards,omitempty"`
+}
+
+func (x *GuessRecord) Reset() {
+	*x = GuessRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordle_v1_wordle_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuessRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuessRecord) ProtoMessage() {}
+
+func (x *GuessRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_wordle_v1_wordle_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuessRecord.ProtoReflect.Descriptor instead.
+func (*GuessRecord) Descriptor() ([]byte, []int) {
+	return file_wordle_v1_wordle_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GuessRecord) GetGuess() string {
+	if x != nil {
+		return x.Guess
+	}
+	return ""
+}
+
+func (x *GuessRecord) GetFeedback() []*LetterFeedback {
+	if x != nil {
+		return x.Feedback
+	}
+	return nil
+}
+
+func (x *GuessRecord) GetBoards() []*BoardFeedback {
+	if x != nil {
+		return x.Boards
+	}
+	return nil
+}
+
+type BoardState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Board  int32 `protobuf:"varint,1,opt,name=board,proto3" json:"board,omitempty"`
+	Solved bool  `protobuf:"varint,2,opt,name=solved,proto3" json:"solved,omitempty"`
+	// Guesses it took to solve the board
+	SolvedIn int32 `protobuf:"varint,3,opt,name=solved_in,json=solvedIn,proto3" json:"solved_in,omitempty"`
+	// Set once the game ends
+	Target string `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *BoardState) Reset() {
+	*x = BoardState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordle_v1_wordle_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardState) ProtoMessage() {}
+
+func (x *BoardState) ProtoReflect() protoreflect.Message {
+	mi := &file_wordle_v1_wordle_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardState.ProtoReflect.Descriptor instead.
+func (*BoardState) Descriptor() ([]byte, []int) {
+	return file_wordle_v1_wordle_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BoardState) GetBoard() int32 {
+	if x != nil {
+		return x.Board
+	}
+	return 0
+}
+
+func (x *BoardState) GetSolved() bool {
+	if x != nil {
+		return x.Solved
+	}
+	return false
+}
+
+func (x *BoardState) GetSolvedIn() int32 {
+	if x != nil {
+		return x.SolvedIn
+	}
+	return 0
+}
+
+func (x *BoardState) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type KeyState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Letter string `protobuf:"bytes,1,opt,name=letter,proto3" json:"letter,omitempty"`
+	// One of "correct", "present", "absent", "unused"
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Multi-board games: the status on each board
+	Boards []string `protobuf:"bytes,3,rep,name=boards,proto3" json:"boards,omitempty"`
+}
+
+func (x *KeyState) Reset() {
+	*x = KeyState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordle_v1_wordle_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyState) ProtoMessage() {}
+
+func (x *KeyState) ProtoReflect() protoreflect.Message {
+	mi := &file_wordle_v1_wordle_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyState.ProtoReflect.Descriptor instead.
+func (*KeyState) Descriptor() ([]byte, []int) {
+	return file_wordle_v1_wordle_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *KeyState) GetLetter() string {
+	if x != nil {
+		return x.Letter
+	}
+	return ""
+}
+
+func (x *KeyState) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *KeyState) GetBoards() []string {
+	if x != nil {
+		return x.Boards
+	}
+	return nil
+}
+
+type BoardFeedback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Board    int32             `protobuf:"varint,1,opt,name=board,proto3" json:"board,omitempty"`
+	Feedback []*LetterFeedback `protobuf:"bytes,2,rep,name=feedback,proto3" json:"feedback,omitempty"`
+	Solved   bool              `protobuf:"varint,3,opt,name=solved,proto3" json:"solved,omitempty"`
+}
+
+func (x *BoardFeedback) Reset() {
+	*x = BoardFeedback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordle_v1_wordle_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardFeedback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardFeedback) ProtoMessage() {}
+
+func (x *BoardFeedback) ProtoReflect() protoreflect.Message {
+	mi := &file_wordle_v1_wordle_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardFeedback.ProtoReflect.Descriptor instead.
+func (*BoardFeedback) Descriptor() ([]byte, []int) {
+	return file_wordle_v1_wordle_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BoardFeedback) GetBoard() int32 {
+	if x != nil {
+		return x.Board
+	}
+	return 0
+}
+
+func (x *BoardFeedback) GetFeedback() []*LetterFeedback {
+	if x != nil {
+		return x.Feedback
+	}
+	return nil
+}
+
+func (x *BoardFeedback) GetSolved() bool {
+	if x != nil {
+		return x.Solved
+	}
+	return false
+}
+
+// Game is the public view of a game; target is only set once the game ends.
+type Game struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Mode         string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	Lang         string `protobuf:"bytes,3,opt,name=lang,proto3" json:"lang,omitempty"`
+	Size         int32  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	MaxAttempts  int32  `protobuf:"varint,5,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	HardMode     bool   `protobuf:"varint,6,opt,name=hard_mode,json=hardMode,proto3" json:"hard_mode,omitempty"`
+	PuzzleNumber int32  `protobuf:"varint,7,opt,name=puzzle_number,json=puzzleNumber,proto3" json:"puzzle_number,omitempty"`
+	// Opaque reference to the target for sharing and replays
+	PuzzleToken string `protobuf:"bytes,8,opt,name=puzzle_token,json=puzzleToken,proto3" json:"puzzle_token,omitempty"`
+	// One of "in_progress", "won", "lost"
+	Status            string         `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	RemainingAttempts int32          `protobuf:"varint,10,opt,name=remaining_attempts,json=remainingAttempts,proto3" json:"remaining_attempts,omitempty"`
+	Guesses           []*GuessRecord `protobuf:"bytes,11,rep,name=guesses,proto3" json:"guesses,omitempty"`
+	Target            string         `protobuf:"bytes,12,opt,name=target,proto3" json:"target,omitempty"`
+	// Absurdle games: answers still possible
+	Candidates int32 `protobuf:"varint,13,opt,name=candidates,proto3" json:"candidates,omitempty"`
+	// Multi-board games only
+	Boards   []*BoardState `protobuf:"bytes,14,rep,name=boards,proto3" json:"boards,omitempty"`
+	Keyboard []*KeyState   `protobuf:"bytes,15,rep,name=keyboard,proto3" json:"keyboard,omitempty"`
+}
+
+func (x *Game) Reset() {
+	*x = Game{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordle_v1_wordle_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Game) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Game) ProtoMessage() {}
+
+func (x *Game) ProtoReflect() protoreflect.Message {
+	mi := &file_wordle_v1_wordle_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Game.ProtoReflect.Descriptor instead.
+func (*Game) Descriptor() ([]byte, []int) {
+	return file_wordle_v1_wordle_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Game) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Game) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Game) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+func (x *Game) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Game) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Game) GetHardMode() bool {
+	if x != nil {
+		return x.HardMode
+	}
+	return false
+}
+
+func (x *Game) GetPuzzleNumber() int32 {
+	if x != nil {
+		return x.PuzzleNumber
+	}
+	return 0
+}
+
+func (x *Game) GetPuzzleToken() string {
+	if x != nil {
+		return x.PuzzleToken
+	}
+	return ""
+}
+
+func (x *Game) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Game) GetRemainingAttempts() int32 {
+	if x != nil {
+		return x.RemainingAttempts
+	}
+	return 0
+}
+
+func (x *Game) GetGuesses() []*GuessRecord {
+	if x != nil {
+		return x.Guesses
+	}
+	return nil
+}
+
+func (x *Game) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Game) GetCandidates() int32 {
+	if x != nil {
+		return x.Candidates
+	}
+	return 0
+}
+
+func (x *Game) GetBoards() []*BoardState {
+	if x != nil {
+		return x.Boards
+	}
+	return nil
+}
+
+func (x *Game) GetKeyboard() []*KeyState {
+	if x != nil {
+		return x.Keyboard
+	}
+	return nil
+}
+
+type SubmitGuessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId            string            `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Feedback          []*LetterFeedback `protobuf:"bytes,2,rep,name=feedback,proto3" json:"feedback,omitempty"`
+	Status            string            `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	RemainingAttempts int32             `protobuf:"varint,4,opt,name=remaining_attempts,json=remainingAttempts,proto3" json:"remaining_attempts,omitempty"`
+	Target            string            `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	// Multi-board games: one entry per board unsolved before the guess
+	Boards []*BoardFeedback `protobuf:"bytes,6,rep,name=boards,proto3" json:"boards,omitempty"`
+	// Multi-board games, once finished
+	Targets  []string    `protobuf:"bytes,7,rep,name=targets,proto3" json:"targets,omitempty"`
+	Keyboard []*KeyState `protobuf:"bytes,8,rep,name=keyboard,proto3" json:"keyboard,omitempty"`
+}
+
+func (x *SubmitGuessResponse) Reset() {
+	*x = SubmitGuessResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordle_v1_wordle_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitGuessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitGuessResponse) ProtoMessage() {}
+
+func (x *SubmitGuessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordle_v1_wordle_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitGuessResponse.ProtoReflect.Descriptor instead.
+func (*SubmitGuessResponse) Descriptor() ([]byte, []int) {
+	return file_wordle_v1_wordle_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SubmitGuessResponse) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SubmitGuessResponse) GetFeedback() []*LetterFeedback {
+	if x != nil {
+		return x.Feedback
+	}
+	return nil
+}
+
+func (x *SubmitGuessResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SubmitGuessResponse) GetRemainingAttempts() int32 {
+	if x != nil {
+		return x.RemainingAttempts
+	}
+	return 0
+}
+
+func (x *SubmitGuessResponse) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *SubmitGuessResponse) GetBoards() []*BoardFeedback {
+	if x != nil {
+		return x.Boards
+	}
+	return nil
+}
+
+func (x *SubmitGuessResponse) GetTargets() []string {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *SubmitGuessResponse) GetKeyboard() []*KeyState {
+	if x != nil {
+		return x.Keyboard
+	}
+	return nil
+}
+
+type GetDailyInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetDailyInfoRequest) Reset() {
+	*x = GetDailyInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordle_v1_wordle_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDailyInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyInfoRequest) ProtoMessage() {}
+
+func (x *GetDailyInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordle_v1_wordle_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetDailyInfoRequest) Descriptor() ([]byte, []int) {
+	return file_wordle_v1_wordle_proto_rawDescGZIP(), []int{12}
+}
+
+type DailyInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PuzzleNumber     int32                  `protobuf:"varint,1,opt,name=puzzle_number,json=puzzleNumber,proto3" json:"puzzle_number,omitempty"`
+	Title            string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Date             string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Timezone         string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	NextRolloverAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=next_rollover_at,json=nextRolloverAt,proto3" json:"next_rollover_at,omitempty"`
+	SecondsUntilNext int64                  `protobuf:"varint,6,opt,name=seconds_until_next,json=secondsUntilNext,proto3" json:"seconds_until_next,omitempty"`
+}
+
+func (x *DailyInfo) Reset() {
+	*x = DailyInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordle_v1_wordle_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DailyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyInfo) ProtoMessage() {}
+
+func (x *DailyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_wordle_v1_wordle_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyInfo.ProtoReflect.Descriptor instead.
+func (*DailyInfo) Descriptor() ([]byte, []int) {
+	return file_wordle_v1_wordle_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DailyInfo) GetPuzzleNumber() int32 {
+	if x != nil {
+		return x.PuzzleNumber
+	}
+	return 0
+}
+
+func (x *DailyInfo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DailyInfo) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyInfo) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *DailyInfo) GetNextRolloverAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRolloverAt
+	}
+	return nil
+}
+
+func (x *DailyInfo) GetSecondsUntilNext() int64 {
+	if x != nil {
+		return x.SecondsUntilNext
+	}
+	return 0
+}
+
+type ValidateWordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lang string `protobuf:"bytes,1,opt,name=lang,proto3" json:"lang,omitempty"`
+	Word string `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
+}
+
+func (x *ValidateWordRequest) Reset() {
+	*x = ValidateWordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordle_v1_wordle_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateWordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateWordRequest) ProtoMessage() {}
+
+func (x *ValidateWordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordle_v1_wordle_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateWordRequest.ProtoReflect.Descriptor instead.
+func (*ValidateWordRequest) Descriptor() ([]byte, []int) {
+	return file_wordle_v1_wordle_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ValidateWordRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+func (x *ValidateWordRequest) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+type ValidateWordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// The word as the game sees it, e.g. lower-cased
+	Normalized string `protobuf:"bytes,2,opt,name=normalized,proto3" json:"normalized,omitempty"`
+}
+
+func (x *ValidateWordResponse) Reset() {
+	*x = ValidateWordResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordle_v1_wordle_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateWordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateWordResponse) ProtoMessage() {}
+
+func (x *ValidateWordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordle_v1_wordle_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateWordResponse.ProtoReflect.Descriptor instead.
+func (*ValidateWordResponse) Descriptor() ([]byte, []int) {
+	return file_wordle_v1_wordle_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ValidateWordResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateWordResponse) GetNormalized() string {
+	if x != nil {
+		return x.Normalized
+	}
+	return ""
+}
+
+var File_wordle_v1_wordle_proto protoreflect.FileDescriptor
+
+var file_wordle_v1_wordle_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x64,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x40, 0x0a, 0x0e, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x46, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x11, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x47, 0x75, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6c, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67,
+	0x12, 0x14, 0x0a, 0x05, 0x67, 0x75, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x67, 0x75, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x22, 0x6e, 0x0a, 0x12,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x47, 0x75, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52,
+	0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x75, 0x7a,
+	0x7a, 0x6c, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xd0, 0x01, 0x0a,
+	0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68, 0x61, 0x72, 0x64, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x7a, 0x7a, 0x6c,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x22,
+	0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x43, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x75, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x67, 0x75, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x67, 0x75, 0x65, 0x73, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x0b, 0x47, 0x75, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x75, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x75, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x08,
+	0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62,
+	0x61, 0x63, 0x6b, 0x12, 0x30, 0x0a, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x73, 0x22, 0x6f, 0x0a, 0x0a, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x49, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x52, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x22, 0x74, 0x0a, 0x0d, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x12, 0x35, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x08,
+	0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64,
+	0x22, 0xeb, 0x03, 0x0a, 0x04, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x61, 0x6e,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x61, 0x72, 0x64,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68, 0x61, 0x72,
+	0x64, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x75,
+	0x7a, 0x7a, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x75,
+	0x7a, 0x7a, 0x6c, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x67, 0x75, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x67,
+	0x75, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2d,
+	0x0a, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x12, 0x2f, 0x0a,
+	0x08, 0x6b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x22, 0xc1,
+	0x02, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x75, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x35, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x08, 0x66, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d,
+	0x0a, 0x12, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52,
+	0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x73, 0x12, 0x2f, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xea, 0x01, 0x0a, 0x09, 0x44, 0x61,
+	0x69, 0x6c, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75, 0x7a, 0x7a, 0x6c,
+	0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x70, 0x75, 0x7a, 0x7a, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f,
+	0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f,
+	0x6e, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x76, 0x65, 0x72, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x3d, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x61, 0x6e,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x4c, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x32, 0xaa, 0x03, 0x0a, 0x06, 0x57, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x12, 0x49,
+	0x0a, 0x0a, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x47, 0x75, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x2e, 0x77,
+	0x6f, 0x72, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x47, 0x75,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x6f, 0x72,
+	0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x47, 0x75, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12,
+	0x19, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x77, 0x6f, 0x72,
+	0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x75, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x2e, 0x77, 0x6f, 0x72,
+	0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x75, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x64,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x75, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x44, 0x61, 0x69, 0x6c, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x64,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x77, 0x6f, 0x72, 0x64,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x4f, 0x0a, 0x0c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x12,
+	0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x27, 0x5a, 0x25, 0x57, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x76, 0x31,
+	0x3b, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_wordle_v1_wordle_proto_rawDescOnce sync.Once
+	file_wordle_v1_wordle_proto_rawDescData = file_wordle_v1_wordle_proto_rawDesc
+)
+
+func file_wordle_v1_wordle_proto_rawDescGZIP() []byte {
+	file_wordle_v1_wordle_proto_rawDescOnce.Do(func() {
+		file_wordle_v1_wordle_proto_rawDescData = protoimpl.X.CompressGZIP(file_wordle_v1_wordle_proto_rawDescData)
+	})
+	return file_wordle_v1_wordle_proto_rawDescData
+}
+
+var file_wordle_v1_wordle_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_wordle_v1_wordle_proto_goTypes = []any{
+	(*LetterFeedback)(nil),        // 0: wordle.v1.LetterFeedback
+	(*ScoreGuessRequest)(nil),     // 1: wordle.v1.ScoreGuessRequest
+	(*ScoreGuessResponse)(nil),    // 2: wordle.v1.ScoreGuessResponse
+	(*StartGameRequest)(nil),      // 3: wordle.v1.StartGameRequest
+	(*GetGameRequest)(nil),        // 4: wordle.v1.GetGameRequest
+	(*SubmitGuessRequest)(nil),    // 5: wordle.v1.SubmitGuessRequest
+	(*GuessRecord)(nil),           // 6: wordle.v1.GuessRecord
+	(*BoardState)(nil),            // 7: wordle.v1.BoardState
+	(*KeyState)(nil),              // 8: wordle.v1.KeyState
+	(*BoardFeedback)(nil),         // 9: wordle.v1.BoardFeedback
+	(*Game)(nil),                  // 10: wordle.v1.Game
+	(*SubmitGuessResponse)(nil),   // 11: wordle.v1.SubmitGuessResponse
+	(*GetDailyInfoRequest)(nil),   // 12: wordle.v1.GetDailyInfoRequest
+	(*DailyInfo)(nil),             // 13: wordle.v1.DailyInfo
+	(*ValidateWordRequest)(nil),   // 14: wordle.v1.ValidateWordRequest
+	(*ValidateWordResponse)(nil),  // 15: wordle.v1.ValidateWordResponse
+	(*timestamppb.Timestamp)(nil), // 16: google.protobuf.Timestamp
+}
+var file_wordle_v1_wordle_proto_depIdxs = []int32{
+	0,  // 0: wordle.v1.ScoreGuessResponse.feedback:type_name -> wordle.v1.LetterFeedback
+	0,  // 1: wordle.v1.GuessRecord.feedback:type_name -> wordle.v1.LetterFeedback
+	9,  // 2: wordle.v1.GuessRecord.boards:type_name -> wordle.v1.BoardFeedback
+	0,  // 3: wordle.v1.BoardFeedback.feedback:type_name -> wordle.v1.LetterFeedback
+	6,  // 4: wordle.v1.Game.guesses:type_name -> wordle.v1.GuessRecord
+	7,  // 5: wordle.v1.Game.boards:type_name -> wordle.v1.BoardState
+	8,  // 6: wordle.v1.Game.keyboard:type_name -> wordle.v1.KeyState
+	0,  // 7: wordle.v1.SubmitGuessResponse.feedback:type_name -> wordle.v1.LetterFeedback
+	9,  // 8: wordle.v1.SubmitGuessResponse.boards:type_name -> wordle.v1.BoardFeedback
+	8,  // 9: wordle.v1.SubmitGuessResponse.keyboard:type_name -> wordle.v1.KeyState
+	16, // 10: wordle.v1.DailyInfo.next_rollover_at:type_name -> google.protobuf.Timestamp
+	1,  // 11: wordle.v1.Wordle.ScoreGuess:input_type -> wordle.v1.ScoreGuessRequest
+	3,  // 12: wordle.v1.Wordle.StartGame:input_type -> wordle.v1.StartGameRequest
+	4,  // 13: wordle.v1.Wordle.GetGame:input_type -> wordle.v1.GetGameRequest
+	5,  // 14: wordle.v1.Wordle.SubmitGuess:input_type -> wordle.v1.SubmitGuessRequest
+	12, // 15: wordle.v1.Wordle.GetDailyInfo:input_type -> wordle.v1.GetDailyInfoRequest
+	14, // 16: wordle.v1.Wordle.ValidateWord:input_type -> wordle.v1.ValidateWordRequest
+	2,  // 17: wordle.v1.Wordle.ScoreGuess:output_type -> wordle.v1.ScoreGuessResponse
+	10, // 18: wordle.v1.Wordle.StartGame:output_type -> wordle.v1.Game
+	10, // 19: wordle.v1.Wordle.GetGame:output_type -> wordle.v1.Game
+	11, // 20: wordle.v1.Wordle.SubmitGuess:output_type -> wordle.v1.SubmitGuessResponse
+	13, // 21: wordle.v1.Wordle.GetDailyInfo:output_type -> wordle.v1.DailyInfo
+	15, // 22: wordle.v1.Wordle.ValidateWord:output_type -> wordle.v1.ValidateWordResponse
+	17, // [17:23] is the sub-list for method output_type
+	11, // [11:17] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_wordle_v1_wordle_proto_init() }
+func file_wordle_v1_wordle_proto_init() {
+	if File_wordle_v1_wordle_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_wordle_v1_wordle_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*LetterFeedback); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordle_v1_wordle_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ScoreGuessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordle_v1_wordle_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ScoreGuessResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordle_v1_wordle_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*StartGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordle_v1_wordle_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordle_v1_wordle_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*SubmitGuessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordle_v1_wordle_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*GuessRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordle_v1_wordle_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*BoardState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordle_v1_wordle_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*KeyState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordle_v1_wordle_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*BoardFeedback); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordle_v1_wordle_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*Game); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordle_v1_wordle_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*SubmitGuessResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordle_v1_wordle_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDailyInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordle_v1_wordle_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*DailyInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordle_v1_wordle_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*ValidateWordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordle_v1_wordle_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*ValidateWordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_wordle_v1_wordle_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_wordle_v1_wordle_proto_goTypes,
+		DependencyIndexes: file_wordle_v1_wordle_proto_depIdxs,
+		MessageInfos:      file_wordle_v1_wordle_proto_msgTypes,
+	}.Build()
+	File_wordle_v1_wordle_proto = out.File
+	file_wordle_v1_wordle_proto_rawDesc = nil
+	file_wordle_v1_wordle_proto_goTypes = nil
+	file_wordle_v1_wordle_proto_depIdxs = nil
+}
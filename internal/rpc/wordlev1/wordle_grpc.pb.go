@@ -0,0 +1,328 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: wordle/v1/wordle.proto
+
+package wordlev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	Wordle_ScoreGuess_FullMethodName   = "/wordle.v1.Wordle/ScoreGuess"
+	Wordle_StartGame_FullMethodName    = "/wordle.v1.Wordle/StartGame"
+	Wordle_GetGame_FullMethodName      = "/wordle.v1.Wordle/GetGame"
+	Wordle_SubmitGuess_FullMethodName  = "/wordle.v1.Wordle/SubmitGuess"
+	Wordle_GetDailyInfo_FullMethodName = "/wordle.v1.Wordle/GetDailyInfo"
+	Wordle_ValidateWord_FullMethodName = "/wordle.v1.Wordle/ValidateWord"
+)
+
+// WordleClient is the client API for Wordle service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Wordle mirrors the REST API for backend clients. Both transports run on the
+// same game logic, so a request behaves the same whichever way it arrives.
+//
+// Authenticated calls carry the same bearer token as the REST API in the
+// "authorization" metadata key. Games started with a token belong to its
+// player and can only be read or played with it.
+type WordleClient interface {
+	// ScoreGuess scores a single guess against a random word, the daily word or
+	// a replayed puzzle, like GET /random and GET /daily/.
+	ScoreGuess(ctx context.Context, in *ScoreGuessRequest, opts ...grpc.CallOption) (*ScoreGuessResponse, error)
+	// StartGame creates a game, like POST /games.
+	StartGame(ctx context.Context, in *StartGameRequest, opts ...grpc.CallOption) (*Game, error)
+	// GetGame returns the current state of a game, like GET /games/{id}.
+	GetGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (*Game, error)
+	// SubmitGuess plays a guess in a game, like POST /games/{id}/guesses.
+	SubmitGuess(ctx context.Context, in *SubmitGuessRequest, opts ...grpc.CallOption) (*SubmitGuessResponse, error)
+	// GetDailyInfo describes the active daily puzzle, like GET /daily/info.
+	GetDailyInfo(ctx context.Context, in *GetDailyInfoRequest, opts ...grpc.CallOption) (*DailyInfo, error)
+	// ValidateWord reports whether a word is accepted as a guess.
+	ValidateWord(ctx context.Context, in *ValidateWordRequest, opts ...grpc.CallOption) (*ValidateWordResponse, error)
+}
+
+type wordleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWordleClient(cc grpc.ClientConnInterface) WordleClient {
+	return &wordleClient{cc}
+}
+
+func (c *wordleClient) ScoreGuess(ctx context.Context, in *ScoreGuessRequest, opts ...grpc.CallOption) (*ScoreGuessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScoreGuessResponse)
+	err := c.cc.Invoke(ctx, Wordle_ScoreGuess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordleClient) StartGame(ctx context.Context, in *StartGameRequest, opts ...grpc.CallOption) (*Game, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Game)
+	err := c.cc.Invoke(ctx, Wordle_StartGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordleClient) GetGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (*Game, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Game)
+	err := c.cc.Invoke(ctx, Wordle_GetGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordleClient) SubmitGuess(ctx context.Context, in *SubmitGuessRequest, opts ...grpc.CallOption) (*SubmitGuessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitGuessResponse)
+	err := c.cc.Invoke(ctx, Wordle_SubmitGuess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordleClient) GetDailyInfo(ctx context.Context, in *GetDailyInfoRequest, opts ...grpc.CallOption) (*DailyInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DailyInfo)
+	err := c.cc.Invoke(ctx, Wordle_GetDailyInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordleClient) ValidateWord(ctx context.Context, in *ValidateWordRequest, opts ...grpc.CallOption) (*ValidateWordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateWordResponse)
+	err := c.cc.Invoke(ctx, Wordle_ValidateWord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WordleServer is the server API for Wordle service.
+// All implementations must embed UnimplementedWordleServer
+// for forward compatibility
+//
+// Wordle mirrors the REST API for backend clients. Both transports run on the
+// same game logic, so a request behaves the same whichever way it arrives.
+//
+// Authenticated calls carry the same bearer token as the REST API in the
+// "authorization" metadata key. Games started with a token belong to its
+// player and can only be read or played with it.
+type WordleServer interface {
+	// ScoreGuess scores a single guess against a random word, the daily word or
+	// a replayed puzzle, like GET /random and GET /daily/.
+	ScoreGuess(context.Context, *ScoreGuessRequest) (*ScoreGuessResponse, error)
+	// StartGame creates a game, like POST /games.
+	StartGame(context.Context, *StartGameRequest) (*Game, error)
+	// GetGame returns the current state of a game, like GET /games/{id}.
+	GetGame(context.Context, *GetGameRequest) (*Game, error)
+	// SubmitGuess plays a guess in a game, like POST /games/{id}/guesses.
+	SubmitGuess(context.Context, *SubmitGuessRequest) (*SubmitGuessResponse, error)
+	// GetDailyInfo describes the active daily puzzle, like GET /daily/info.
+	GetDailyInfo(context.Context, *GetDailyInfoRequest) (*DailyInfo, error)
+	// ValidateWord reports whether a word is accepted as a guess.
+	ValidateWord(context.Context, *ValidateWordRequest) (*ValidateWordResponse, error)
+	mustEmbedUnimplementedWordleServer()
+}
+
+// UnimplementedWordleServer must be embedded to have forward compatible implementations.
+type UnimplementedWordleServer struct {
+}
+
+func (UnimplementedWordleServer) ScoreGuess(context.Context, *ScoreGuessRequest) (*ScoreGuessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScoreGuess not implemented")
+}
+func (UnimplementedWordleServer) StartGame(context.Context, *StartGameRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartGame not implemented")
+}
+func (UnimplementedWordleServer) GetGame(context.Context, *GetGameRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGame not implemented")
+}
+func (UnimplementedWordleServer) SubmitGuess(context.Context, *SubmitGuessRequest) (*SubmitGuessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitGuess not implemented")
+}
+func (UnimplementedWordleServer) GetDailyInfo(context.Context, *GetDailyInfoRequest) (*DailyInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDailyInfo not implemented")
+}
+func (UnimplementedWordleServer) ValidateWord(context.Context, *ValidateWordRequest) (*ValidateWordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateWord not implemented")
+}
+func (UnimplementedWordleServer) mustEmbedUnimplementedWordleServer() {}
+
+// UnsafeWordleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WordleServer will
+// result in compilation errors.
+type UnsafeWordleServer interface {
+	mustEmbedUnimplementedWordleServer()
+}
+
+func RegisterWordleServer(s grpc.ServiceRegistrar, srv WordleServer) {
+	s.RegisterService(&Wordle_ServiceDesc, srv)
+}
+
+func _Wordle_ScoreGuess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScoreGuessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordleServer).ScoreGuess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wordle_ScoreGuess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordleServer).ScoreGuess(ctx, req.(*ScoreGuessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wordle_StartGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordleServer).StartGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wordle_StartGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordleServer).StartGame(ctx, req.(*StartGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wordle_GetGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordleServer).GetGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wordle_GetGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordleServer).GetGame(ctx, req.(*GetGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wordle_SubmitGuess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitGuessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordleServer).SubmitGuess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wordle_SubmitGuess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordleServer).SubmitGuess(ctx, req.(*SubmitGuessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wordle_GetDailyInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordleServer).GetDailyInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wordle_GetDailyInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordleServer).GetDailyInfo(ctx, req.(*GetDailyInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wordle_ValidateWord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateWordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordleServer).ValidateWord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wordle_ValidateWord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordleServer).ValidateWord(ctx, req.(*ValidateWordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Wordle_ServiceDesc is the grpc.ServiceDesc for Wordle service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Wordle_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wordle.v1.Wordle",
+	HandlerType: (*WordleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScoreGuess",
+			Handler:    _Wordle_ScoreGuess_Handler,
+		},
+		{
+			MethodName: "StartGame",
+			Handler:    _Wordle_StartGame_Handler,
+		},
+		{
+			MethodName: "GetGame",
+			Handler:    _Wordle_GetGame_Handler,
+		},
+		{
+			MethodName: "SubmitGuess",
+			Handler:    _Wordle_SubmitGuess_Handler,
+		},
+		{
+			MethodName: "GetDailyInfo",
+			Handler:    _Wordle_GetDailyInfo_Handler,
+		},
+		{
+			MethodName: "ValidateWord",
+			Handler:    _Wordle_ValidateWord_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "wordle/v1/wordle.proto",
+}
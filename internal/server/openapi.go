@@ -0,0 +1,292 @@
+package server
+
+import (
+	"net/http"
+
+	"Wordle/internal/handler"
+	"Wordle/internal/openapi"
+	"Wordle/internal/response"
+)
+
+// apiDoc describes every route registered in RegisterFiberRoutes. A route
+// without an entry here fails TestOpenAPICoversRoutes.
+func apiDoc() *openapi.Document {
+	doc := openapi.New(openapi.Info{
+		Title:       "Wordle",
+		Version:     "1.0.0",
+		Description: "Word games in several languages: single guesses, stored games, challenges, races and leaderboards.",
+	})
+
+	lang := openapi.Param{Name: "lang", Description: "Language code; English when empty"}
+	gameID := openapi.Param{Name: "id", Description: "Game ID"}
+
+	doc.Add(
+		openapi.Route{
+			Method: http.MethodGet, Path: "/", Summary: "Greeting", Tags: []string{"meta"},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: map[string]string{}}},
+		},
+		openapi.Route{
+			Method: http.MethodGet, Path: "/openapi.json", Summary: "This document", Tags: []string{"meta"},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: map[string]any{}}},
+		},
+		openapi.Route{
+			Method: http.MethodGet, Path: "/docs", Summary: "Rendered API documentation", Tags: []string{"meta"},
+			Replies: []openapi.Reply{{Status: http.StatusOK, ContentType: "text/html"}},
+		},
+		openapi.Route{
+			Method: http.MethodGet, Path: "/docs/:file", Summary: "Scripts and styles of the documentation page", Tags: []string{"meta"},
+			Params:  []openapi.Param{{Name: "file", Description: "File name"}},
+			Replies: []openapi.Reply{{Status: http.StatusOK, ContentType: "text/javascript"}},
+			Errors:  []int{http.StatusNotFound},
+		},
+		openapi.Route{
+			Method: http.MethodGet, Path: "/healthz", Summary: "Liveness probe; does not check dependencies", Tags: []string{"meta"},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: response.Health{}}},
+		},
+		openapi.Route{
+			Method: http.MethodGet, Path: "/readyz", Summary: "Readiness probe checking the database and word lists", Tags: []string{"meta"},
+			Replies: []openapi.Reply{
+				{Status: http.StatusOK, Body: response.Health{}},
+				{Status: http.StatusServiceUnavailable, Description: "A dependency is down; the body says which", Body: response.Health{}},
+			},
+		},
+
+		// Words
+		openapi.Route{
+			Method: http.MethodPost, Path: "/wordseg", Summary: "Submit a custom word (legacy path)", Tags: []string{"words"},
+			Auth: openapi.AuthOptional, Body: response.BodyWordsegPost{},
+			Replies: []openapi.Reply{{Status: http.StatusAccepted, Body: response.WordSubmission{}}},
+			Errors:  []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity, http.StatusInternalServerError},
+		},
+		openapi.Route{
+			Method: http.MethodPost, Path: "/words/submissions", Summary: "Submit a custom word for moderation", Tags: []string{"words"},
+			Auth: openapi.AuthOptional, Body: response.BodyWordsegPost{},
+			Replies: []openapi.Reply{{Status: http.StatusAccepted, Body: response.WordSubmission{}}},
+			Errors:  []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity, http.StatusInternalServerError},
+		},
+		openapi.Route{
+			Method: http.MethodGet, Path: "/word/:word", Summary: "Score a guess against a given word", Tags: []string{"words"},
+			Extra:   []openapi.Param{lang, {Name: "guess", Required: true}},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: []response.LetterFeedback{}}},
+			Errors:  []int{http.StatusBadRequest},
+		},
+
+		// Single guesses
+		openapi.Route{
+			Method: http.MethodGet, Path: "/random", Summary: "Score a guess against a random word", Tags: []string{"guesses"},
+			Query: handler.GuessQuery{},
+			Replies: []openapi.Reply{{
+				Status:      http.StatusOK,
+				Description: "The feedback; the X-Puzzle-Token header replays the same target through ?puzzle=",
+				Body:        []response.LetterFeedback{},
+			}},
+			Errors: []int{http.StatusBadRequest, http.StatusForbidden, http.StatusUnprocessableEntity, http.StatusInternalServerError},
+		},
+		openapi.Route{
+			Method: http.MethodGet, Path: "/daily/", Summary: "Score a guess against today's word", Tags: []string{"guesses"},
+			Query:   handler.GuessQuery{},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: []response.LetterFeedback{}}},
+			Errors:  []int{http.StatusBadRequest, http.StatusForbidden, http.StatusUnprocessableEntity, http.StatusInternalServerError},
+		},
+		openapi.Route{
+			Method: http.MethodGet, Path: "/daily/info", Summary: "Describe today's puzzle", Tags: []string{"guesses"},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: response.DailyInfo{}}},
+		},
+		openapi.Route{
+			Method: http.MethodGet, Path: "/events", Summary: "Server-Sent Events feed of rollovers, solve counters and game updates", Tags: []string{"guesses"},
+			Auth: openapi.AuthOptional,
+			Extra: []openapi.Param{
+				{Name: "games", Description: "Comma-separated IDs of games to follow"},
+				{Name: "last_event_id", Type: "integer", Description: "Resume after this event, for clients that cannot send Last-Event-ID"},
+			},
+			Replies: []openapi.Reply{{Status: http.StatusOK, ContentType: "text/event-stream"}},
+			Errors:  []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusInternalServerError},
+		},
+
+		// Accounts
+		openapi.Route{
+			Method: http.MethodPost, Path: "/auth/register", Summary: "Create an account", Tags: []string{"accounts"},
+			Body:    response.BodyAuthPost{},
+			Replies: []openapi.Reply{{Status: http.StatusCreated, Body: response.AuthToken{}}},
+			Errors:  []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity, http.StatusInternalServerError},
+		},
+		openapi.Route{
+			Method: http.MethodPost, Path: "/auth/login", Summary: "Log in", Tags: []string{"accounts"},
+			Body:    response.BodyAuthPost{},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: response.AuthToken{}}},
+			Errors:  []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusUnprocessableEntity, http.StatusInternalServerError},
+		},
+		openapi.Route{
+			Method: http.MethodGet, Path: "/users/me", Summary: "The logged-in player", Tags: []string{"accounts"},
+			Auth:    openapi.AuthUser,
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: response.UserProfile{}}},
+			Errors:  []int{http.StatusUnauthorized},
+		},
+		openapi.Route{
+			Method: http.MethodGet, Path: "/users/me/stats", Summary: "The logged-in player's statistics", Tags: []string{"accounts"},
+			Auth:    openapi.AuthUser,
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: response.UserStats{}}},
+			Errors:  []int{http.StatusUnauthorized, http.StatusInternalServerError},
+		},
+
+		// Games
+		openapi.Route{
+			Method: http.MethodPost, Path: "/games", Summary: "Start a game", Tags: []string{"games"},
+			Auth: openapi.AuthOptional, Body: response.BodyGamePost{},
+			Replies: []openapi.Reply{{Status: http.StatusCreated, Body: response.GameState{}}},
+			Errors:  []int{http.StatusBadRequest, http.StatusForbidden, http.StatusUnprocessableEntity, http.StatusInternalServerError},
+		},
+		openapi.Route{
+			Method: http.MethodGet, Path: "/games/:id", Summary: "The state of a game", Tags: []string{"games"},
+			Auth: openapi.AuthOptional, Params: []openapi.Param{gameID},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: response.GameState{}}},
+			Errors:  []int{http.StatusForbidden, http.StatusNotFound, http.StatusInternalServerError},
+		},
+		openapi.Route{
+			Method: http.MethodPost, Path: "/games/:id/guesses", Summary: "Play a guess", Tags: []string{"games"},
+			Auth: openapi.AuthOptional, Params: []openapi.Param{gameID}, Body: response.BodyGuessPost{},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: response.GameGuessResponse{}}},
+			Errors: []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusConflict,
+				http.StatusUnprocessableEntity, http.StatusInternalServerError},
+		},
+		openapi.Route{
+			Method: http.MethodGet, Path: "/games/:id/hint", Summary: "A hint for a classic game; hinted games leave stats and leaderboards", Tags: []string{"games"},
+			Auth: openapi.AuthOptional, Params: []openapi.Param{gameID},
+			Extra:   []openapi.Param{{Name: "type", Enum: []string{"letter", "candidates"}}},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: response.GameHint{}}},
+			Errors:  []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusConflict, http.StatusInternalServerError},
+		},
+		openapi.Route{
+			Method: http.MethodGet, Path: "/games/:id/keyboard", Summary: "The keyboard colours of a game", Tags: []string{"games"},
+			Auth: openapi.AuthOptional, Params: []openapi.Param{gameID},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: response.Keyboard{}}},
+			Errors:  []int{http.StatusForbidden, http.StatusNotFound, http.StatusInternalServerError},
+		},
+		openapi.Route{
+			Method: http.MethodGet, Path: "/games/:id/share", Summary: "Render a game as a shareable grid", Tags: []string{"games"},
+			Auth: openapi.AuthOptional, Params: []openapi.Param{gameID},
+			Extra:   []openapi.Param{{Name: "style", Enum: []string{"standard", "high_contrast", "plain"}}},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: response.ShareText{}}},
+			Errors:  []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusConflict, http.StatusInternalServerError},
+		},
+		openapi.Route{
+			Method: http.MethodPost, Path: "/share/parse", Summary: "Read a pasted result grid", Tags: []string{"games"},
+			Body:    response.BodySharePost{},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: response.SharedResult{}}},
+			Errors:  []int{http.StatusBadRequest, http.StatusUnprocessableEntity},
+		},
+
+		// Challenges
+		openapi.Route{
+			Method: http.MethodPost, Path: "/challenges", Summary: "Challenge others with a word", Tags: []string{"challenges"},
+			Auth: openapi.AuthOptional, Body: response.BodyChallengePost{},
+			Replies: []openapi.Reply{{Status: http.StatusCreated, Body: response.ChallengeInfo{}}},
+			Errors:  []int{http.StatusBadRequest, http.StatusUnprocessableEntity, http.StatusInternalServerError},
+		},
+		openapi.Route{
+			Method: http.MethodGet, Path: "/challenges/:code", Summary: "Describe a challenge", Tags: []string{"challenges"},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: response.ChallengeInfo{}}},
+			Errors:  []int{http.StatusNotFound, http.StatusInternalServerError},
+		},
+		openapi.Route{
+			Method: http.MethodPost, Path: "/challenges/:code/guesses", Summary: "Play a guess in a challenge", Tags: []string{"challenges"},
+			Auth: openapi.AuthOptional, Body: response.BodyChallengeGuessPost{},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: response.GameGuessResponse{}}},
+			Errors: []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusConflict,
+				http.StatusUnprocessableEntity, http.StatusInternalServerError},
+		},
+		openapi.Route{
+			Method: http.MethodGet, Path: "/users/me/challenges", Summary: "Challenges created by the logged-in player", Tags: []string{"challenges"},
+			Auth:    openapi.AuthUser,
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: []response.ChallengeInfo{}}},
+			Errors:  []int{http.StatusUnauthorized, http.StatusInternalServerError},
+		},
+
+		// Races
+		openapi.Route{
+			Method: http.MethodPost, Path: "/race/rooms", Summary: "Open a race room", Tags: []string{"races"},
+			Body:    response.BodyRacePost{},
+			Replies: []openapi.Reply{{Status: http.StatusCreated, Body: response.RaceRoom{}}},
+			Errors:  []int{http.StatusBadRequest, http.StatusUnprocessableEntity},
+		},
+		openapi.Route{
+			Method: http.MethodGet, Path: "/race/rooms/:code/ws", Summary: "Join a race room over WebSocket", Tags: []string{"races"},
+			Extra: []openapi.Param{
+				{Name: "name", Description: "Name of a new player"},
+				{Name: "player", Description: "ID of a player reconnecting after a dropped connection"},
+			},
+			Replies: []openapi.Reply{{Status: http.StatusSwitchingProtocols}},
+			Errors:  []int{http.StatusNotFound, http.StatusUpgradeRequired},
+		},
+
+		// Leaderboards
+		openapi.Route{
+			Method: http.MethodGet, Path: "/leaderboards/daily/:number", Summary: "Fastest solvers of a daily puzzle", Tags: []string{"leaderboards"},
+			Params:  []openapi.Param{{Name: "number", Type: "integer", Description: "Daily puzzle number"}},
+			Query:   handler.LeaderboardQuery{},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: response.Leaderboard{}}},
+			Errors:  []int{http.StatusBadRequest, http.StatusUnprocessableEntity, http.StatusInternalServerError},
+		},
+		openapi.Route{
+			Method: http.MethodGet, Path: "/leaderboards/win-rate", Summary: "Players with the best win rate", Tags: []string{"leaderboards"},
+			Query:   handler.LeaderboardQuery{},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: response.Leaderboard{}}},
+			Errors:  []int{http.StatusBadRequest, http.StatusUnprocessableEntity, http.StatusInternalServerError},
+		},
+		openapi.Route{
+			Method: http.MethodGet, Path: "/leaderboards/streak", Summary: "Players with the longest streaks", Tags: []string{"leaderboards"},
+			Query:   handler.LeaderboardQuery{},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: response.Leaderboard{}}},
+			Errors:  []int{http.StatusBadRequest, http.StatusUnprocessableEntity, http.StatusInternalServerError},
+		},
+
+		// Solver
+		openapi.Route{
+			Method: http.MethodPost, Path: "/solver/candidates", Summary: "Words still consistent with a guess history", Tags: []string{"solver"},
+			Body:    response.BodySolverPost{},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: response.SolverCandidates{}}},
+			Errors:  []int{http.StatusBadRequest, http.StatusUnprocessableEntity},
+		},
+		openapi.Route{
+			Method: http.MethodPost, Path: "/solver/suggest", Summary: "The best next guesses for a guess history", Tags: []string{"solver"},
+			Body:    response.BodySolverPost{},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: response.SolverSuggestions{}}},
+			Errors:  []int{http.StatusBadRequest, http.StatusUnprocessableEntity},
+		},
+
+		// Admin
+		openapi.Route{
+			Method: http.MethodGet, Path: "/admin/words/:word/tier", Summary: "Whether a word can be an answer", Tags: []string{"admin"},
+			Auth: openapi.AuthAdmin, Extra: []openapi.Param{lang},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: response.WordTier{}}},
+			Errors:  []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound},
+		},
+		openapi.Route{
+			Method: http.MethodPut, Path: "/admin/words/:word/tier", Summary: "Promote a word to an answer or demote it to a guess", Tags: []string{"admin"},
+			Auth: openapi.AuthAdmin, Body: response.BodyWordTierPut{},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: response.WordTier{}}},
+			Errors: []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound,
+				http.StatusUnprocessableEntity, http.StatusInternalServerError},
+		},
+		openapi.Route{
+			Method: http.MethodGet, Path: "/admin/words/submissions", Summary: "List submitted words", Tags: []string{"admin"},
+			Auth:    openapi.AuthAdmin,
+			Extra:   []openapi.Param{{Name: "status", Enum: []string{"pending", "approved", "rejected", "all"}}},
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: []response.WordSubmission{}}},
+			Errors:  []int{http.StatusForbidden, http.StatusInternalServerError},
+		},
+		openapi.Route{
+			Method: http.MethodPost, Path: "/admin/words/submissions/:id/approve", Summary: "Approve a submitted word", Tags: []string{"admin"},
+			Auth:    openapi.AuthAdmin,
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: response.WordSubmission{}}},
+			Errors:  []int{http.StatusForbidden, http.StatusNotFound, http.StatusConflict, http.StatusInternalServerError},
+		},
+		openapi.Route{
+			Method: http.MethodPost, Path: "/admin/words/submissions/:id/reject", Summary: "Reject a submitted word", Tags: []string{"admin"},
+			Auth:    openapi.AuthAdmin,
+			Replies: []openapi.Reply{{Status: http.StatusOK, Body: response.WordSubmission{}}},
+			Errors:  []int{http.StatusForbidden, http.StatusNotFound, http.StatusConflict, http.StatusInternalServerError},
+		},
+	)
+	return doc
+}
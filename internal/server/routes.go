@@ -2,16 +2,66 @@ package server
 
 import (
 	"Wordle/internal/handler"
+	"Wordle/internal/models"
 
 	"github.com/gofiber/fiber/v2"
 )
 
 func (s *FiberServer) RegisterFiberRoutes() {
+	sessions := s.sessions()
+
+	s.App.Use(handler.RequestID())
+	s.App.Use(handler.AuthMiddleware(s.db, s.tokens))
+	s.App.Use(handler.SeedAccessMiddleware(s.debug, s.adminToken))
+
 	s.App.Get("/", s.HelloWorldHandler)
-	s.App.Post("/wordseg", handler.WordSegHandler(&s.db))
-	s.App.Get("/daily/", handler.DailyHandler)
+	s.App.Get("/openapi.json", handler.OpenAPIHandler(apiDoc()))
+	s.App.Get("/docs", handler.DocsHandler)
+	s.App.Get("/docs/:file", handler.DocsAssetHandler)
+	s.App.Get("/healthz", handler.HealthzHandler)
+	s.App.Get("/readyz", handler.ReadyzHandler(s.db, s.loadStoredWords))
+	s.App.Post("/wordseg", handler.WordSegHandler(s.db))
+	s.App.Post("/words/submissions", handler.WordSegHandler(s.db))
+	s.App.Get("/daily/", handler.DailyHandler(sessions))
+	s.App.Get("/daily/info", handler.DailyInfoHandler(sessions))
+	s.App.Get("/events", handler.EventsHandler(s.db, s.daily, s.feed))
 	s.App.Get("/word/:word", handler.WordHandler)
-	s.App.Get("/random", handler.RandomHandler)
+	s.App.Get("/random", handler.RandomHandler(sessions))
+
+	s.App.Post("/auth/register", handler.RegisterHandler(s.db, s.tokens))
+	s.App.Post("/auth/login", handler.LoginHandler(s.db, s.tokens))
+	s.App.Get("/users/me", handler.RequireUser, handler.MeHandler)
+	s.App.Get("/users/me/stats", handler.RequireUser, handler.UserStatsHandler(s.db, s.daily))
+
+	s.App.Post("/games", handler.CreateGameHandler(sessions))
+	s.App.Get("/games/:id", handler.GetGameHandler(s.db))
+	s.App.Post("/games/:id/guesses", handler.GameGuessHandler(s.db, sessions))
+	s.App.Get("/games/:id/hint", handler.GameHintHandler(s.db))
+	s.App.Get("/games/:id/keyboard", handler.GameKeyboardHandler(s.db))
+	s.App.Get("/games/:id/share", handler.GameShareHandler(s.db))
+	s.App.Post("/share/parse", handler.ShareParseHandler(s.db))
+
+	s.App.Post("/challenges", handler.CreateChallengeHandler(s.db, s.puzzles))
+	s.App.Get("/challenges/:code", handler.GetChallengeHandler(s.db))
+	s.App.Post("/challenges/:code/guesses", handler.ChallengeGuessHandler(s.db, s.puzzles, sessions))
+	s.App.Get("/users/me/challenges", handler.RequireUser, handler.ListMyChallengesHandler(s.db))
+
+	s.App.Post("/race/rooms", handler.CreateRaceHandler(s.races))
+	s.App.Get("/race/rooms/:code/ws", handler.RaceRoomMiddleware(s.races), handler.RaceSocketHandler())
+
+	s.App.Get("/leaderboards/daily/:number", handler.DailyLeaderboardHandler(s.db))
+	s.App.Get("/leaderboards/win-rate", handler.WinRateLeaderboardHandler(s.db))
+	s.App.Get("/leaderboards/streak", handler.StreakLeaderboardHandler(s.db))
+
+	s.App.Post("/solver/candidates", handler.SolverCandidatesHandler)
+	s.App.Post("/solver/suggest", handler.SolverSuggestHandler)
+
+	admin := s.App.Group("/admin", handler.AdminMiddleware(s.adminToken))
+	admin.Get("/words/:word/tier", handler.GetWordTierHandler)
+	admin.Put("/words/:word/tier", handler.SetWordTierHandler(s.db))
+	admin.Get("/words/submissions", handler.ListSubmissionsHandler(s.db))
+	admin.Post("/words/submissions/:id/approve", handler.ReviewSubmissionHandler(s.db, models.WordApproved))
+	admin.Post("/words/submissions/:id/reject", handler.ReviewSubmissionHandler(s.db, models.WordRejected))
 
 }
 
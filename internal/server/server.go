@@ -1,26 +1,154 @@
 package server
 
 import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
 	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc"
 
+	"Wordle/internal/auth"
 	"Wordle/internal/database"
+	"Wordle/internal/events"
+	"Wordle/internal/handler"
+	"Wordle/internal/models"
+	"Wordle/internal/puzzle"
+	"Wordle/internal/race"
+	"Wordle/internal/rpc"
+	"Wordle/internal/session"
+	"Wordle/internal/utils"
 )
 
 type FiberServer struct {
 	*fiber.App
 
-	db database.Service
+	db         database.Service
+	daily      *utils.DailySchedule
+	adminToken string
+	tokens     *auth.Tokens
+	puzzles    *puzzle.Codec
+	races      *race.Lobby
+	feed       *events.Broker
+	// debug lets every client pick targets with a raw seed
+	debug bool
+
+	wordsMu     sync.Mutex
+	wordsLoaded bool // Whether the stored words have been merged into the word lists
+}
+
+// fiberConfig is the configuration of the app. Every error a handler
+// returns is written by handler.ErrorHandler.
+func fiberConfig() fiber.Config {
+	return fiber.Config{
+		ServerHeader: "Wordle",
+		AppName:      "Wordle",
+		ErrorHandler: handler.ErrorHandler,
+	}
 }
 
 func New() *FiberServer {
+	return newServer(database.New())
+}
+
+func newServer(db database.Service) *FiberServer {
+	daily, err := utils.NewDailySchedule(os.Getenv("DAILY_TIMEZONE"), os.Getenv("DAILY_EPOCH"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	puzzles, err := puzzle.NewCodec(secretFromEnv("PUZZLE_SECRET"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	debug, _ := strconv.ParseBool(os.Getenv("DEBUG"))
+
 	server := &FiberServer{
-		App: fiber.New(fiber.Config{
-			ServerHeader: "Wordle",
-			AppName:      "Wordle",
-		}),
+		App: fiber.New(fiberConfig()),
 
-		db: database.New(),
+		db:         db,
+		daily:      daily,
+		adminToken: os.Getenv("ADMIN_TOKEN"),
+		tokens:     auth.NewTokens(secretFromEnv("AUTH_SECRET"), auth.DefaultTokenTTL),
+		puzzles:    puzzles,
+		races:      race.NewLobby(),
+		feed:       events.NewBroker(events.DefaultHistory, events.DefaultBuffer),
+		debug:      debug,
 	}
 
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := server.loadStoredWords(ctx); err != nil {
+		// Serve the embedded word lists meanwhile; /readyz retries the load
+		// and reports not ready until it succeeds
+		log.Printf("failed to load stored words: %v", err)
+	}
+
+	go server.feed.WatchRollover(context.Background(), daily)
+
 	return server
 }
+
+// loadStoredWords merges approved submissions into the embedded word lists,
+// then replays admin tier overrides on top so promoted custom words become
+// answers. Once it has succeeded it does nothing.
+func (s *FiberServer) loadStoredWords(ctx context.Context) error {
+	s.wordsMu.Lock()
+	defer s.wordsMu.Unlock()
+	if s.wordsLoaded {
+		return nil
+	}
+
+	tiers, err := s.db.ListWordTiers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load word tiers: %w", err)
+	}
+	approved, err := s.db.ListWordsByStatus(ctx, models.WordApproved)
+	if err != nil {
+		return fmt.Errorf("failed to load approved words: %w", err)
+	}
+
+	for _, w := range approved {
+		if ws, err := utils.Lookup(w.Lang); err == nil {
+			ws.AddWord(w.Content)
+		}
+	}
+	for _, t := range tiers {
+		if ws, err := utils.Lookup(t.Lang); err == nil {
+			ws.SetTier(t.Word, t.Tier)
+		}
+	}
+	s.wordsLoaded = true
+	return nil
+}
+
+// sessions builds the game service that the REST and gRPC APIs share.
+func (s *FiberServer) sessions() *session.Service {
+	return session.New(s.db, s.daily, s.puzzles, s.feed)
+}
+
+// GRPC builds the gRPC API, which serves the same games and players as the
+// REST routes.
+func (s *FiberServer) GRPC() *grpc.Server {
+	return rpc.New(s.sessions(), rpc.NewAuthenticator(s.db, s.tokens, s.adminToken, s.debug))
+}
+
+// secretFromEnv reads a signing secret from the environment. Without one a
+// random secret is generated, so anything signed with it only survives until
+// the next restart.
+func secretFromEnv(name string) []byte {
+	secret := []byte(os.Getenv(name))
+	if len(secret) == 0 {
+		log.Printf("%s is not set, using a random secret", name)
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			log.Fatal(err)
+		}
+	}
+	return secret
+}
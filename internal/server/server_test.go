@@ -2,21 +2,44 @@
 package server
 
 import (
+	"bufio"
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
+	"net"
+	"net/http"
 	"net/http/httptest"
 
+	"Wordle/internal/auth"
+	"Wordle/internal/database"
+	"Wordle/internal/events"
+	"Wordle/internal/models"
+	"Wordle/internal/openapi"
+	"Wordle/internal/puzzle"
+	"Wordle/internal/race"
+	"Wordle/internal/response"
+	"Wordle/internal/utils"
+
+	"github.com/fasthttp/websocket"
 	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/assert"
 )
 
 type MockDB struct{}
 
+// testPuzzles seals the puzzle tokens of every test server.
+var testPuzzles, _ = puzzle.NewCodec([]byte("test-secret"))
+
 // TestHelloWorldHandler tests the '/' endpoint.
 func TestHelloWorldHandler(t *testing.T) {
 	// Initialize Fiber app
-	app := fiber.New()
+	app := fiber.New(fiberConfig())
 
 	server := &FiberServer{
 		App: app,
@@ -42,3 +65,1392 @@ func TestHelloWorldHandler(t *testing.T) {
 
 	assert.Equal(t, expected, responseBody)
 }
+
+// TestOpenAPICoversRoutes fails when a route is registered without a
+// description in apiDoc.
+func TestOpenAPICoversRoutes(t *testing.T) {
+	app := fiber.New(fiberConfig())
+
+	server := &FiberServer{
+		App: app,
+	}
+
+	server.RegisterFiberRoutes()
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/openapi.json", nil), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var doc openapi.Document
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&doc))
+	assert.Equal(t, openapi.Version, doc.OpenAPI)
+	assert.Contains(t, doc.Components.Schemas, "GameState")
+
+	routes := app.GetRoutes(true)
+	assert.NotEmpty(t, routes)
+	for _, route := range routes {
+		// Fiber answers HEAD for every GET route by itself
+		if route.Method == fiber.MethodHead {
+			continue
+		}
+		assert.True(t, doc.Has(route.Method, route.Path), "%s %s is not in the OpenAPI document", route.Method, route.Path)
+	}
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/docs", nil), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get(fiber.HeaderContentType), "text/html")
+	page, _ := io.ReadAll(resp.Body)
+	assert.NotContains(t, string(page), "https://", "the docs page must not load anything from elsewhere")
+
+	// Everything the page loads is served by the app itself
+	for _, file := range []string{"swagger-ui.css", "swagger-ui-bundle.js", "docs.js"} {
+		resp, err = app.Test(httptest.NewRequest("GET", "/docs/"+file, nil), -1)
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode, file)
+		assert.Contains(t, string(page), "/docs/"+file)
+	}
+	resp, err = app.Test(httptest.NewRequest("GET", "/docs/index.html", nil), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+}
+
+// TestGameFlow tests creating a game, guessing and resuming it.
+func TestGameFlow(t *testing.T) {
+	app := fiber.New(fiberConfig())
+
+	server := &FiberServer{
+		puzzles: testPuzzles,
+		debug:   true,
+		App:     app,
+		db:      database.NewMemory(),
+	}
+
+	server.RegisterFiberRoutes()
+
+	req := httptest.NewRequest("POST", "/games", strings.NewReader(`{"size":5,"seed":1,"max_attempts":2}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusCreated, resp.StatusCode)
+
+	var created response.GameState
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+	assert.NotEmpty(t, created.ID)
+	assert.Equal(t, "in_progress", created.Status)
+	assert.Equal(t, 2, created.RemainingAttempts)
+	assert.Empty(t, created.Target)
+
+	req = httptest.NewRequest("POST", "/games/"+created.ID+"/guesses", strings.NewReader(`{"guess":"apple"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err = app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var outcome response.GameGuessResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&outcome))
+	assert.Len(t, outcome.Feedback, 5)
+	assert.Equal(t, "in_progress", outcome.Status)
+	assert.Equal(t, 1, outcome.RemainingAttempts)
+	assert.Empty(t, outcome.Target)
+
+	req = httptest.NewRequest("POST", "/games/"+created.ID+"/guesses", strings.NewReader(`{"guess":"crate"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err = app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&outcome))
+	assert.Equal(t, "lost", outcome.Status)
+	assert.Equal(t, "brick", outcome.Target)
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/games/"+created.ID, nil), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var resumed response.GameState
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&resumed))
+	assert.Len(t, resumed.Guesses, 2)
+	assert.Equal(t, "brick", resumed.Target)
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/games/unknown", nil), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+}
+
+func TestMultiBoardGame(t *testing.T) {
+	app := fiber.New(fiberConfig())
+
+	daily, err := utils.NewDailySchedule("", "")
+	assert.NoError(t, err)
+
+	server := &FiberServer{
+		daily:   daily,
+		puzzles: testPuzzles,
+		App:     app,
+		db:      database.NewMemory(),
+	}
+
+	server.RegisterFiberRoutes()
+
+	req := httptest.NewRequest("POST", "/games", strings.NewReader(`{"mode":"dordle","max_attempts":2}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusCreated, resp.StatusCode)
+
+	var created response.GameState
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+	assert.Equal(t, "dordle", created.Mode)
+	assert.Len(t, created.Boards, 2)
+	assert.Empty(t, created.PuzzleToken)
+
+	req = httptest.NewRequest("POST", "/games/"+created.ID+"/guesses", strings.NewReader(`{"guess":"zzzzz"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err = app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+
+	// A valid guess that is never an answer, so neither board is solved early
+	var outcome response.GameGuessResponse
+	for _, word := range []string{"cwtch", "cwtch"} {
+		req = httptest.NewRequest("POST", "/games/"+created.ID+"/guesses", strings.NewReader(`{"guess":"`+word+`"}`))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err = app.Test(req, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&outcome))
+		assert.Len(t, outcome.Boards, 2)
+		assert.Len(t, outcome.Boards[1].Feedback, 5)
+	}
+	assert.Equal(t, "lost", outcome.Status)
+	assert.Len(t, outcome.Targets, 2)
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/games/"+created.ID, nil), -1)
+	assert.NoError(t, err)
+
+	var state response.GameState
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&state))
+	assert.Equal(t, outcome.Targets[0], state.Boards[0].Target)
+	assert.Len(t, state.Guesses[0].Boards, 2)
+	assert.NotEmpty(t, state.Keyboard)
+	for _, key := range state.Keyboard {
+		assert.Len(t, key.Boards, 2)
+	}
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/games/"+created.ID+"/share", nil), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+
+	for _, body := range []string{`{"mode":"quordle","hard_mode":true}`, `{"mode":"octordle","daily":true}`, `{"mode":"hexadecordle"}`} {
+		req = httptest.NewRequest("POST", "/games", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err = app.Test(req, -1)
+		assert.NoError(t, err)
+		assert.Contains(t, []int{fiber.StatusBadRequest, fiber.StatusUnprocessableEntity}, resp.StatusCode, body)
+	}
+}
+
+func TestAbsurdleGame(t *testing.T) {
+	app := fiber.New(fiberConfig())
+
+	server := &FiberServer{
+		puzzles: testPuzzles,
+		App:     app,
+		db:      database.NewMemory(),
+	}
+
+	server.RegisterFiberRoutes()
+
+	req := httptest.NewRequest("POST", "/games", strings.NewReader(`{"mode":"absurdle"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusCreated, resp.StatusCode)
+
+	var created response.GameState
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+	assert.Equal(t, "absurdle", created.Mode)
+	assert.Equal(t, 20, created.MaxAttempts)
+	assert.Empty(t, created.PuzzleToken)
+
+	req = httptest.NewRequest("POST", "/games/"+created.ID+"/guesses", strings.NewReader(`{"guess":"crate"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err = app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/games/"+created.ID, nil), -1)
+	assert.NoError(t, err)
+
+	var state response.GameState
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&state))
+	assert.Less(t, state.Candidates, created.Candidates)
+	assert.Greater(t, state.Candidates, 1)
+	assert.Empty(t, state.Target)
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/games/"+created.ID+"/hint", nil), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+}
+
+func TestGameKeyboard(t *testing.T) {
+	app := fiber.New(fiberConfig())
+
+	server := &FiberServer{
+		puzzles: testPuzzles,
+		debug:   true,
+		App:     app,
+		db:      database.NewMemory(),
+	}
+
+	server.RegisterFiberRoutes()
+
+	req := httptest.NewRequest("POST", "/games", strings.NewReader(`{"size":5,"seed":1}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+
+	var created response.GameState
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+	assert.Len(t, created.Keyboard, 26)
+
+	req = httptest.NewRequest("POST", "/games/"+created.ID+"/guesses", strings.NewReader(`{"guess":"crate"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err = app.Test(req, -1)
+	assert.NoError(t, err)
+
+	var outcome response.GameGuessResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&outcome))
+	assert.Len(t, outcome.Keyboard, 26)
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/games/"+created.ID+"/keyboard", nil), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var kb response.Keyboard
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&kb))
+	assert.Equal(t, created.ID, kb.GameID)
+	statuses := map[string]string{}
+	for _, key := range kb.Keys {
+		statuses[key.Letter] = key.Status
+	}
+	// The target is "brick"
+	assert.Equal(t, "present", statuses["c"])
+	assert.Equal(t, "correct", statuses["r"])
+	assert.Equal(t, "absent", statuses["a"])
+	assert.Equal(t, "unused", statuses["z"])
+
+	req = httptest.NewRequest("POST", "/games", strings.NewReader(`{"lang":"de"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err = app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+	assert.Len(t, created.Keyboard, 30)
+	assert.Equal(t, "ß", created.Keyboard[29].Letter)
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/games/unknown/keyboard", nil), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+}
+
+// TestDailyInfoHandler tests the '/daily/info' endpoint.
+func TestDailyInfoHandler(t *testing.T) {
+	app := fiber.New(fiberConfig())
+
+	daily, err := utils.NewDailySchedule("", "")
+	assert.NoError(t, err)
+
+	server := &FiberServer{
+		App:   app,
+		db:    nil,
+		daily: daily,
+	}
+
+	server.RegisterFiberRoutes()
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/daily/info", nil), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var info response.DailyInfo
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&info))
+	assert.Equal(t, daily.PuzzleNumber(time.Now()), info.PuzzleNumber)
+	assert.Equal(t, fmt.Sprintf("Wordle #%d", info.PuzzleNumber), info.Title)
+	assert.True(t, info.SecondsUntilNext > 0 && info.SecondsUntilNext <= 24*60*60)
+}
+
+// lookupOnlyDB fails every GetUser, which loads the user's words, so a test
+// notices when authentication loads more than the user itself.
+type lookupOnlyDB struct {
+	database.Service
+}
+
+func (d *lookupOnlyDB) GetUser(ctx context.Context, id string) (*models.User, error) {
+	return nil, errors.New("GetUser called; authentication should use LookupUser")
+}
+
+// downDB is a database that cannot be reached until up is set.
+type downDB struct {
+	database.Service
+	up bool
+}
+
+func (d *downDB) Health(ctx context.Context) error {
+	if d.up {
+		return d.Service.Health(ctx)
+	}
+	return context.DeadlineExceeded
+}
+
+func (d *downDB) ListWordTiers(ctx context.Context) ([]models.WordTier, error) {
+	if d.up {
+		return d.Service.ListWordTiers(ctx)
+	}
+	return nil, context.DeadlineExceeded
+}
+
+func (d *downDB) ListWordsByStatus(ctx context.Context, status string) ([]models.Word, error) {
+	if d.up {
+		return d.Service.ListWordsByStatus(ctx, status)
+	}
+	return nil, context.DeadlineExceeded
+}
+
+// TestHealthProbes tests the '/healthz' and '/readyz' endpoints.
+func TestHealthProbes(t *testing.T) {
+	tests := []struct {
+		name     string
+		db       database.Service
+		target   string
+		expected int
+		status   string
+	}{
+		{name: "Live", db: database.NewMemory(), target: "/healthz", expected: fiber.StatusOK, status: response.HealthOK},
+		{name: "Live without database", db: &downDB{Service: database.NewMemory()}, target: "/healthz", expected: fiber.StatusOK, status: response.HealthOK},
+		{name: "Ready", db: database.NewMemory(), target: "/readyz", expected: fiber.StatusOK, status: response.HealthOK},
+		{name: "Not ready without database", db: &downDB{Service: database.NewMemory()}, target: "/readyz", expected: fiber.StatusServiceUnavailable, status: response.HealthUnavailable},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New(fiberConfig())
+			server := &FiberServer{
+				App: app,
+				db:  tt.db,
+			}
+			server.RegisterFiberRoutes()
+
+			resp, err := app.Test(httptest.NewRequest("GET", tt.target, nil), -1)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, resp.StatusCode)
+
+			var health response.Health
+			assert.NoError(t, json.NewDecoder(resp.Body).Decode(&health))
+			assert.Equal(t, tt.status, health.Status)
+			assert.NotEmpty(t, health.Build.GoVersion)
+			if tt.target == "/healthz" {
+				assert.Nil(t, health.Database)
+				return
+			}
+
+			assert.Equal(t, tt.status, health.Database.Status)
+			if tt.status == response.HealthOK {
+				assert.Empty(t, health.Database.Error)
+			} else {
+				assert.Equal(t, "database unreachable", health.Database.Error)
+			}
+			assert.Len(t, health.Dictionaries, len(utils.Languages()))
+			for _, d := range health.Dictionaries {
+				assert.Equal(t, response.HealthOK, d.Status)
+				assert.Positive(t, d.Answers[5], d.Lang)
+			}
+		})
+	}
+}
+
+// TestStartWithoutDatabase tests that the server starts while the database
+// is down and reports itself not ready until it can load the stored words.
+func TestStartWithoutDatabase(t *testing.T) {
+	db := &downDB{Service: database.NewMemory()}
+	server := newServer(db)
+	server.RegisterFiberRoutes()
+
+	resp, err := server.Test(httptest.NewRequest("GET", "/readyz", nil), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusServiceUnavailable, resp.StatusCode)
+
+	var health response.Health
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&health))
+	assert.Equal(t, response.HealthUnavailable, health.Database.Status)
+	assert.Equal(t, response.HealthUnavailable, health.StoredWords.Status)
+	// The probe is public, so driver errors stay in the logs
+	assert.Equal(t, "database unreachable", health.Database.Error)
+	assert.Equal(t, "stored words not loaded", health.StoredWords.Error)
+
+	// Once the database is back the next probe loads the words
+	db.up = true
+	resp, err = server.Test(httptest.NewRequest("GET", "/readyz", nil), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+}
+
+// TestGameHintHandler tests the '/games/:id/hint' endpoint.
+func TestGameHintHandler(t *testing.T) {
+	app := fiber.New(fiberConfig())
+
+	server := &FiberServer{
+		puzzles: testPuzzles,
+		debug:   true,
+		App:     app,
+		db:      database.NewMemory(),
+	}
+
+	server.RegisterFiberRoutes()
+
+	req := httptest.NewRequest("POST", "/games", strings.NewReader(`{"seed":1}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+
+	var created response.GameState
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/games/"+created.ID+"/hint", nil), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var hint response.GameHint
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&hint))
+	assert.Equal(t, "letter", hint.Type)
+	assert.Equal(t, 1, hint.Position)
+	assert.Equal(t, "b", hint.Letter)
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/games/"+created.ID+"/hint?type=candidates", nil), -1)
+	assert.NoError(t, err)
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&hint))
+	assert.Equal(t, "candidates", hint.Type)
+	assert.NotEmpty(t, hint.Suggestion)
+	assert.True(t, hint.Remaining > 1)
+}
+
+// TestRandomHandlerLanguages tests the 'lang' query parameter of the '/random' endpoint.
+func TestRandomHandlerLanguages(t *testing.T) {
+	app := fiber.New(fiberConfig())
+
+	server := &FiberServer{
+		puzzles: testPuzzles,
+		App:     app,
+		db:      nil,
+	}
+
+	server.RegisterFiberRoutes()
+
+	tests := []struct {
+		name     string
+		target   string
+		expected int
+	}{
+		{name: "Spanish guess with accents", target: "/random?lang=es&guess=%C3%81RBOL", expected: fiber.StatusOK},
+		{name: "English guess in the Spanish list", target: "/random?lang=es&guess=apple", expected: fiber.StatusBadRequest},
+		{name: "Unknown language", target: "/random?lang=xx&guess=apple", expected: fiber.StatusBadRequest},
+		{name: "Default language", target: "/random?guess=apple", expected: fiber.StatusOK},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := app.Test(httptest.NewRequest("GET", tt.target, nil), -1)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, resp.StatusCode)
+		})
+	}
+}
+
+// TestErrorEnvelope tests that failures of every kind share one error body.
+func TestErrorEnvelope(t *testing.T) {
+	app := fiber.New(fiberConfig())
+
+	server := &FiberServer{
+		puzzles: testPuzzles,
+		App:     app,
+		db:      nil,
+	}
+
+	server.RegisterFiberRoutes()
+
+	tests := []struct {
+		name    string
+		method  string
+		target  string
+		body    string
+		status  int
+		code    string
+		details bool
+	}{
+		{name: "Unknown route", method: "GET", target: "/nowhere", status: fiber.StatusNotFound, code: response.CodeNotFound},
+		{name: "Unknown language", method: "GET", target: "/random?lang=xx&guess=apple", status: fiber.StatusBadRequest, code: response.CodeUnsupportedLanguage},
+		{name: "Invalid word", method: "GET", target: "/random?guess=zzzzz", status: fiber.StatusBadRequest, code: response.CodeInvalidWord},
+		{name: "Raw seed", method: "GET", target: "/random?guess=apple&seed=1", status: fiber.StatusForbidden, code: response.CodeSeedForbidden},
+		{name: "Invalid JSON", method: "POST", target: "/games", body: "{", status: fiber.StatusBadRequest, code: response.CodeInvalidJSON},
+		{name: "Failed validation", method: "POST", target: "/games", body: `{"size": 99}`, status: fiber.StatusUnprocessableEntity, code: response.CodeValidationFailed, details: true},
+		{name: "Anonymous user", method: "GET", target: "/users/me", status: fiber.StatusUnauthorized, code: response.CodeUnauthorized},
+		{name: "Unsupported options", method: "POST", target: "/games", body: `{"mode": "dordle", "hard_mode": true}`, status: fiber.StatusBadRequest, code: response.CodeBadRequest},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-Request-ID", "req-1")
+			resp, err := app.Test(req, -1)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.status, resp.StatusCode)
+
+			var body response.Error
+			assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			assert.Equal(t, tt.code, body.Code)
+			assert.NotEmpty(t, body.Message)
+			assert.Equal(t, "req-1", body.RequestID)
+			assert.Equal(t, tt.details, len(body.Details) > 0)
+			assert.Contains(t, response.ErrorCodes, body.Code)
+		})
+	}
+}
+
+// TestWordTierHandlers tests promoting and demoting words through the admin routes.
+func TestWordTierHandlers(t *testing.T) {
+	app := fiber.New(fiberConfig())
+
+	server := &FiberServer{
+		App:        app,
+		db:         database.NewMemory(),
+		adminToken: "secret",
+	}
+
+	server.RegisterFiberRoutes()
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/admin/words/fjord/tier", nil), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+
+	req := httptest.NewRequest("GET", "/admin/words/fjord/tier", nil)
+	req.Header.Set("X-Admin-Token", "secret")
+	resp, err = app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var tier response.WordTier
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&tier))
+	assert.Equal(t, "guess", tier.Tier)
+
+	req = httptest.NewRequest("PUT", "/admin/words/fjord/tier", strings.NewReader(`{"tier":"answer"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Admin-Token", "secret")
+	resp, err = app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&tier))
+	assert.Equal(t, "answer", tier.Tier)
+
+	tiers, err := server.db.ListWordTiers(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, tiers, 1)
+
+	// Restore the embedded lists for other tests
+	en, _ := utils.Lookup("en")
+	assert.NoError(t, en.SetTier("fjord", "guess"))
+
+	req = httptest.NewRequest("PUT", "/admin/words/kiwis/tier", strings.NewReader(`{"tier":"answer"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Admin-Token", "secret")
+	resp, err = app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+}
+
+// TestWordSubmissionFlow tests submitting a word and approving it through the admin routes.
+func TestWordSubmissionFlow(t *testing.T) {
+	app := fiber.New(fiberConfig())
+
+	server := &FiberServer{
+		App:        app,
+		db:         database.NewMemory(),
+		adminToken: "secret",
+	}
+
+	server.RegisterFiberRoutes()
+
+	adminRequest := func(method, target string) *http.Request {
+		req := httptest.NewRequest(method, target, nil)
+		req.Header.Set("X-Admin-Token", "secret")
+		return req
+	}
+
+	req := httptest.NewRequest("POST", "/wordseg", strings.NewReader(`{"text":"Quokka"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusAccepted, resp.StatusCode)
+
+	var submission response.WordSubmission
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&submission))
+	assert.Equal(t, "quokka", submission.Word)
+	assert.Equal(t, "pending", submission.Status)
+	assert.False(t, utils.IsValidWord("quokka"))
+
+	req = httptest.NewRequest("POST", "/words/submissions", strings.NewReader(`{"text":"apple"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err = app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusConflict, resp.StatusCode)
+
+	resp, err = app.Test(adminRequest("GET", "/admin/words/submissions"), -1)
+	assert.NoError(t, err)
+	var pending []response.WordSubmission
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&pending))
+	assert.Len(t, pending, 1)
+
+	resp, err = app.Test(adminRequest("POST", "/admin/words/submissions/"+submission.ID+"/approve"), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.True(t, utils.IsValidWord("quokka"))
+
+	resp, err = app.Test(adminRequest("POST", "/admin/words/submissions/"+submission.ID+"/reject"), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusConflict, resp.StatusCode)
+
+	resp, err = app.Test(adminRequest("POST", "/admin/words/submissions/missing/approve"), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+}
+
+// TestAuthFlow tests registering, logging in and playing an owned game with a token.
+func TestAuthFlow(t *testing.T) {
+	app := fiber.New(fiberConfig())
+
+	server := &FiberServer{
+		puzzles: testPuzzles,
+		debug:   true,
+		App:     app,
+		db:      &lookupOnlyDB{Service: database.NewMemory()},
+		tokens:  auth.NewTokens([]byte("test-secret"), time.Hour),
+	}
+
+	server.RegisterFiberRoutes()
+
+	post := func(target, body, token string) *http.Response {
+		req := httptest.NewRequest("POST", target, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := app.Test(req, -1)
+		assert.NoError(t, err)
+		return resp
+	}
+
+	resp := post("/auth/register", `{"username":"Alice","password":"correct horse"}`, "")
+	assert.Equal(t, fiber.StatusCreated, resp.StatusCode)
+	var registered response.AuthToken
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&registered))
+	assert.Equal(t, "alice", registered.User.Username)
+	assert.NotEmpty(t, registered.Token)
+
+	resp = post("/auth/register", `{"username":"alice","password":"another one"}`, "")
+	assert.Equal(t, fiber.StatusConflict, resp.StatusCode)
+
+	resp = post("/auth/register", `{"username":"bob","password":"short"}`, "")
+	assert.Equal(t, fiber.StatusUnprocessableEntity, resp.StatusCode)
+
+	// 30 characters but 90 bytes, more than bcrypt accepts
+	resp = post("/auth/register", `{"username":"bob","password":"`+strings.Repeat("€", 30)+`"}`, "")
+	assert.Equal(t, fiber.StatusUnprocessableEntity, resp.StatusCode)
+	var tooLong response.Error
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&tooLong))
+	if assert.Len(t, tooLong.Details, 1) {
+		assert.Equal(t, "maxbytes", tooLong.Details[0].Type)
+	}
+
+	resp = post("/auth/login", `{"username":"alice","password":"wrong password"}`, "")
+	assert.Equal(t, fiber.StatusUnauthorized, resp.StatusCode)
+
+	resp = post("/auth/login", `{"username":"alice","password":"correct horse"}`, "")
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	var login response.AuthToken
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&login))
+	assert.Equal(t, registered.User.ID, login.User.ID)
+
+	req := httptest.NewRequest("GET", "/users/me", nil)
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusUnauthorized, resp.StatusCode)
+
+	req = httptest.NewRequest("GET", "/users/me", nil)
+	req.Header.Set("Authorization", "Bearer "+login.Token)
+	resp, err = app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	req = httptest.NewRequest("GET", "/users/me", nil)
+	req.Header.Set("Authorization", "Bearer not-a-token")
+	resp, err = app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusUnauthorized, resp.StatusCode)
+
+	// Games created with a token belong to that player
+	resp = post("/games", `{"seed":1}`, login.Token)
+	assert.Equal(t, fiber.StatusCreated, resp.StatusCode)
+	var owned response.GameState
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&owned))
+
+	resp = post("/games/"+owned.ID+"/guesses", `{"guess":"apple"}`, "")
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+
+	resp = post("/games/"+owned.ID+"/guesses", `{"guess":"apple"}`, login.Token)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	// Anonymous play keeps working
+	resp = post("/games", `{"seed":1}`, "")
+	assert.Equal(t, fiber.StatusCreated, resp.StatusCode)
+	var anonymous response.GameState
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&anonymous))
+
+	resp = post("/games/"+anonymous.ID+"/guesses", `{"guess":"apple"}`, "")
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+}
+
+// TestUserStats tests that finishing a daily game updates '/users/me/stats'.
+func TestUserStats(t *testing.T) {
+	app := fiber.New(fiberConfig())
+
+	daily, err := utils.NewDailySchedule("", "")
+	assert.NoError(t, err)
+
+	server := &FiberServer{
+		puzzles: testPuzzles,
+		App:     app,
+		db:      database.NewMemory(),
+		daily:   daily,
+		tokens:  auth.NewTokens([]byte("test-secret"), time.Hour),
+	}
+
+	server.RegisterFiberRoutes()
+
+	request := func(method, target, body, token string) *http.Response {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := app.Test(req, -1)
+		assert.NoError(t, err)
+		return resp
+	}
+
+	resp := request("POST", "/auth/register", `{"username":"carol","password":"correct horse"}`, "")
+	var login response.AuthToken
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&login))
+
+	resp = request("POST", "/games", `{"daily":true,"seed":3}`, login.Token)
+	assert.Equal(t, fiber.StatusUnprocessableEntity, resp.StatusCode)
+
+	ws, err := utils.Lookup("en")
+	assert.NoError(t, err)
+	number, target, err := daily.Today(ws, 5)
+	assert.NoError(t, err)
+
+	playDaily := func() {
+		resp := request("POST", "/games", `{"daily":true}`, login.Token)
+		assert.Equal(t, fiber.StatusCreated, resp.StatusCode)
+		var state response.GameState
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&state))
+		assert.Equal(t, number, state.PuzzleNumber)
+
+		resp = request("POST", "/games/"+state.ID+"/guesses", `{"guess":"`+target+`"}`, login.Token)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	}
+
+	// Replaying today's puzzle must not count twice
+	playDaily()
+	playDaily()
+
+	resp = request("GET", "/users/me/stats", "", login.Token)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	var st response.UserStats
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&st))
+	assert.Equal(t, response.UserStats{
+		GamesPlayed:       1,
+		Wins:              1,
+		WinPercentage:     100,
+		CurrentStreak:     1,
+		MaxStreak:         1,
+		GuessDistribution: []int{1},
+	}, st)
+
+	req := httptest.NewRequest("GET", "/users/me/stats", nil)
+	resp, err = app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusUnauthorized, resp.StatusCode)
+}
+
+// TestShareHandlers tests exporting a finished game and parsing the grid back.
+func TestShareHandlers(t *testing.T) {
+	app := fiber.New(fiberConfig())
+
+	server := &FiberServer{
+		puzzles: testPuzzles,
+		debug:   true,
+		App:     app,
+		db:      database.NewMemory(),
+	}
+
+	server.RegisterFiberRoutes()
+
+	post := func(target, body string) *http.Response {
+		req := httptest.NewRequest("POST", target, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req, -1)
+		assert.NoError(t, err)
+		return resp
+	}
+
+	resp := post("/games", `{"seed":1}`)
+	var created response.GameState
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/games/"+created.ID+"/share", nil), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusConflict, resp.StatusCode)
+
+	post("/games/"+created.ID+"/guesses", `{"guess":"crate"}`)
+	post("/games/"+created.ID+"/guesses", `{"guess":"brick"}`)
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/games/"+created.ID+"/share", nil), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	var shared response.ShareText
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&shared))
+	assert.Equal(t, "Wordle 2/6\n\n🟨🟩⬛⬛⬛\n🟩🟩🟩🟩🟩", shared.Text)
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/games/"+created.ID+"/share?style=sepia", nil), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+
+	body, _ := json.Marshal(response.BodySharePost{Text: shared.Text, GameID: created.ID})
+	resp = post("/share/parse", string(body))
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	var parsed response.SharedResult
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&parsed))
+	assert.True(t, parsed.Won)
+	assert.Len(t, parsed.Rows, 2)
+	if assert.NotNil(t, parsed.Verified) {
+		assert.True(t, *parsed.Verified)
+	}
+
+	body, _ = json.Marshal(response.BodySharePost{Text: "Wordle 1/6\n🟩🟩🟩🟩🟩", GameID: created.ID})
+	resp = post("/share/parse", string(body))
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&parsed))
+	if assert.NotNil(t, parsed.Verified) {
+		assert.False(t, *parsed.Verified)
+	}
+
+	resp = post("/share/parse", `{"text":"not a grid"}`)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+}
+
+// TestLeaderboards tests ranking players on the daily and all-time leaderboards.
+func TestLeaderboards(t *testing.T) {
+	app := fiber.New(fiberConfig())
+
+	daily, err := utils.NewDailySchedule("", "")
+	assert.NoError(t, err)
+
+	server := &FiberServer{
+		puzzles: testPuzzles,
+		App:     app,
+		db:      database.NewMemory(),
+		daily:   daily,
+		tokens:  auth.NewTokens([]byte("test-secret"), time.Hour),
+	}
+
+	server.RegisterFiberRoutes()
+
+	request := func(method, target, body, token string) *http.Response {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := app.Test(req, -1)
+		assert.NoError(t, err)
+		return resp
+	}
+
+	ws, _ := utils.Lookup("en")
+	number, target, err := daily.Today(ws, 5)
+	assert.NoError(t, err)
+	opener := "crate"
+	if target == opener {
+		opener = "apple"
+	}
+
+	play := func(username, body string, guesses ...string) response.GameState {
+		resp := request("POST", "/auth/register", `{"username":"`+username+`","password":"correct horse"}`, "")
+		var login response.AuthToken
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&login))
+
+		resp = request("POST", "/games", body, login.Token)
+		var state response.GameState
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&state))
+		for _, guess := range guesses {
+			request("POST", "/games/"+state.ID+"/guesses", `{"guess":"`+guess+`"}`, login.Token)
+		}
+		return state
+	}
+
+	play("slow", `{"daily":true}`, opener, target)
+	fast := play("fast", `{"daily":true}`, target)
+	// Replaying a known puzzle through its token must not earn a ranking
+	replay := play("cheater", `{"puzzle":"`+fast.PuzzleToken+`"}`, target)
+	assert.NotEmpty(t, fast.PuzzleToken)
+	assert.Equal(t, 5, replay.Size)
+	// Neither may a win after asking for a letter
+	hinted := play("hinted", `{"daily":true}`)
+	resp := request("POST", "/auth/login", `{"username":"hinted","password":"correct horse"}`, "")
+	var login response.AuthToken
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&login))
+	resp = request("GET", "/games/"+hinted.ID+"/hint", "", login.Token)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	resp = request("POST", "/games/"+hinted.ID+"/guesses", `{"guess":"`+target+`"}`, login.Token)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	resp = request("GET", fmt.Sprintf("/leaderboards/daily/%d", number), "", "")
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	var board response.Leaderboard
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&board))
+	if assert.Len(t, board.Entries, 2) {
+		assert.Equal(t, "fast", board.Entries[0].Username)
+		assert.Equal(t, 1, board.Entries[0].Rank)
+		assert.Equal(t, 1, board.Entries[0].Guesses)
+		assert.Equal(t, "slow", board.Entries[1].Username)
+	}
+
+	resp = request("GET", fmt.Sprintf("/leaderboards/daily/%d?page=2&per_page=1", number), "", "")
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&board))
+	if assert.Len(t, board.Entries, 1) {
+		assert.Equal(t, "slow", board.Entries[0].Username)
+		assert.Equal(t, 2, board.Entries[0].Rank)
+	}
+
+	// The replayed win never reached the stats the all-time boards read
+	resp = request("GET", "/leaderboards/win-rate?min_played=1", "", "")
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&board))
+	assert.Len(t, board.Entries, 2)
+	for _, e := range board.Entries {
+		assert.NotContains(t, []string{"cheater", "hinted"}, e.Username)
+		assert.Equal(t, 100, e.WinPercentage)
+	}
+
+	resp = request("GET", "/leaderboards/streak", "", "")
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&board))
+	if assert.Len(t, board.Entries, 2) {
+		assert.Equal(t, 1, board.Entries[0].MaxStreak)
+	}
+
+	resp = request("GET", "/leaderboards/daily/latest", "", "")
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+
+	resp = request("GET", "/leaderboards/streak?per_page=1000", "", "")
+	assert.Equal(t, fiber.StatusUnprocessableEntity, resp.StatusCode)
+
+	// Pages far enough out to overflow the offset are rejected up front
+	resp = request("GET", "/leaderboards/streak?page=9223372036854775807&per_page=100", "", "")
+	assert.Equal(t, fiber.StatusUnprocessableEntity, resp.StatusCode)
+}
+
+// TestPuzzleTokens tests that raw seeds need debug or admin access and that
+// puzzle tokens replay a target without revealing it.
+func TestPuzzleTokens(t *testing.T) {
+	app := fiber.New(fiberConfig())
+
+	daily, err := utils.NewDailySchedule("", "")
+	assert.NoError(t, err)
+
+	server := &FiberServer{
+		App:        app,
+		db:         database.NewMemory(),
+		daily:      daily,
+		adminToken: "secret",
+		puzzles:    testPuzzles,
+	}
+
+	server.RegisterFiberRoutes()
+
+	request := func(method, target, body string, admin bool) *http.Response {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if admin {
+			req.Header.Set("X-Admin-Token", "secret")
+		}
+		resp, err := app.Test(req, -1)
+		assert.NoError(t, err)
+		return resp
+	}
+
+	resp := request("GET", "/random?guess=apple&seed=1", "", false)
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+	resp = request("GET", "/daily/?guess=apple&seed=1", "", false)
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+	resp = request("POST", "/games", `{"seed":1}`, false)
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+
+	// Admins may still pick a target; seed 1 selects "brick"
+	resp = request("GET", "/random?guess=apple&seed=1", "", true)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	token := resp.Header.Get("X-Puzzle-Token")
+	assert.NotEmpty(t, token)
+	assert.NotContains(t, token, "brick")
+
+	resp = request("GET", "/random?guess=brick&puzzle="+token, "", false)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	var feedback []response.LetterFeedback
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&feedback))
+	for _, fb := range feedback {
+		assert.Equal(t, "correct", fb.Status)
+	}
+
+	resp = request("POST", "/games", `{"puzzle":"`+token+`"}`, false)
+	assert.Equal(t, fiber.StatusCreated, resp.StatusCode)
+	var state response.GameState
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&state))
+	assert.NotEmpty(t, state.PuzzleToken)
+
+	resp = request("POST", "/games/"+state.ID+"/guesses", `{"guess":"brick"}`, false)
+	var outcome response.GameGuessResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&outcome))
+	assert.Equal(t, "won", outcome.Status)
+
+	resp = request("GET", "/random?guess=apple&puzzle=forged", "", false)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+	resp = request("POST", "/games", `{"puzzle":"forged"}`, false)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+	resp = request("POST", "/games", `{"puzzle":"`+token+`","daily":true}`, false)
+	assert.Equal(t, fiber.StatusUnprocessableEntity, resp.StatusCode)
+}
+
+// TestChallenges tests creating a challenge, playing it and reading its counters.
+func TestChallenges(t *testing.T) {
+	app := fiber.New(fiberConfig())
+
+	server := &FiberServer{
+		App:     app,
+		db:      database.NewMemory(),
+		tokens:  auth.NewTokens([]byte("test-secret"), time.Hour),
+		puzzles: testPuzzles,
+	}
+
+	server.RegisterFiberRoutes()
+
+	request := func(method, target, body, token string) *http.Response {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := app.Test(req, -1)
+		assert.NoError(t, err)
+		return resp
+	}
+
+	resp := request("POST", "/auth/register", `{"username":"dave","password":"correct horse"}`, "")
+	var login response.AuthToken
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&login))
+
+	resp = request("POST", "/challenges", `{"word":"Crate"}`, login.Token)
+	assert.Equal(t, fiber.StatusCreated, resp.StatusCode)
+	var challenge response.ChallengeInfo
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&challenge))
+	assert.Len(t, challenge.Code, 8)
+	assert.Equal(t, 5, challenge.Size)
+	assert.False(t, challenge.Custom)
+
+	resp = request("POST", "/challenges", `{"word":"zzyzx"}`, login.Token)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+	resp = request("POST", "/challenges", `{"word":"zz9zx","custom":true}`, login.Token)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+	resp = request("POST", "/challenges", `{"word":"zzyzx","custom":true}`, login.Token)
+	assert.Equal(t, fiber.StatusCreated, resp.StatusCode)
+	var custom response.ChallengeInfo
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&custom))
+	assert.True(t, custom.Custom)
+
+	// First player needs two guesses and keeps playing the same game
+	resp = request("POST", "/challenges/"+challenge.Code+"/guesses", `{"guess":"apple"}`, "")
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	var outcome response.GameGuessResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&outcome))
+	assert.NotEmpty(t, outcome.GameID)
+	assert.Equal(t, "in_progress", outcome.Status)
+
+	resp = request("POST", "/challenges/"+challenge.Code+"/guesses", `{"guess":"crate","game_id":"`+outcome.GameID+`"}`, "")
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&outcome))
+	assert.Equal(t, "won", outcome.Status)
+
+	// Second player gives up after one guess; an invalid guess does not count
+	resp = request("POST", "/challenges/"+challenge.Code+"/guesses", `{"guess":"zzzzz"}`, "")
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+	request("POST", "/challenges/"+challenge.Code+"/guesses", `{"guess":"apple"}`, "")
+
+	// Custom words can be guessed even though they are not in the dictionary
+	resp = request("POST", "/challenges/"+custom.Code+"/guesses", `{"guess":"zzyzx"}`, "")
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&outcome))
+	assert.Equal(t, "won", outcome.Status)
+
+	resp = request("GET", "/challenges/"+challenge.Code, "", "")
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&challenge))
+	assert.Equal(t, 2, challenge.Attempts)
+	assert.Equal(t, 1, challenge.Solves)
+
+	resp = request("GET", "/users/me/challenges", "", login.Token)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	var mine []response.ChallengeInfo
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&mine))
+	assert.Len(t, mine, 2)
+
+	resp = request("POST", "/challenges/"+custom.Code+"/guesses", `{"guess":"crate","game_id":"`+outcome.GameID+`"}`, "")
+	assert.Equal(t, fiber.StatusConflict, resp.StatusCode)
+	resp = request("POST", "/challenges/"+challenge.Code+"/guesses", `{"guess":"crate","game_id":"`+outcome.GameID+`"}`, "")
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+	resp = request("GET", "/challenges/missing", "", "")
+	assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+}
+
+// TestRaceSockets tests two players racing over real WebSocket connections.
+func TestRaceSockets(t *testing.T) {
+	app := fiber.New(fiberConfig())
+
+	server := &FiberServer{
+		App:   app,
+		db:    database.NewMemory(),
+		races: race.NewLobby(),
+	}
+
+	server.RegisterFiberRoutes()
+
+	req := httptest.NewRequest("POST", "/race/rooms", strings.NewReader(`{"size":5}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusCreated, resp.StatusCode)
+	var room response.RaceRoom
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&room))
+
+	resp, err = app.Test(httptest.NewRequest("GET", room.Socket, nil), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusUpgradeRequired, resp.StatusCode)
+	resp, err = app.Test(httptest.NewRequest("GET", "/race/rooms/missing/ws", nil), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	go app.Listener(ln)
+	defer app.Shutdown()
+
+	dial := func(name string) *websocket.Conn {
+		conn, _, err := websocket.DefaultDialer.Dial("ws://"+ln.Addr().String()+room.Socket+"?name="+name, nil)
+		if err != nil {
+			t.Fatalf("Dial(%s) returned error: %v", name, err)
+		}
+		return conn
+	}
+	read := func(conn *websocket.Conn, typ string) race.Event {
+		conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+		for {
+			var e race.Event
+			if err := conn.ReadJSON(&e); err != nil {
+				t.Fatalf("waiting for %q: %v", typ, err)
+			}
+			if e.Type == typ {
+				return e
+			}
+		}
+	}
+
+	alice := dial("alice")
+	defer alice.Close()
+	state := read(alice, "state")
+	assert.NotEmpty(t, state.PlayerID)
+	assert.Equal(t, "alice", state.Room.Host)
+
+	bob := dial("bob")
+	defer bob.Close()
+	read(bob, "state")
+	assert.Equal(t, "bob", read(alice, "joined").Player)
+
+	assert.NoError(t, bob.WriteJSON(map[string]string{"type": "start"}))
+	assert.Equal(t, race.ErrNotHost.Error(), read(bob, "error").Error)
+
+	assert.NoError(t, alice.WriteJSON(map[string]string{"type": "start"}))
+	read(alice, "started")
+	read(bob, "started")
+
+	// A guess that is never an answer, so the race cannot end on it
+	assert.NoError(t, alice.WriteJSON(map[string]string{"type": "guess", "guess": "cwtch"}))
+	own := read(alice, "guess")
+	assert.Len(t, own.Feedback, 5)
+	progress := read(bob, "progress")
+	assert.Equal(t, "alice", progress.Player)
+	assert.Len(t, progress.Row, 5)
+	assert.Empty(t, progress.Feedback)
+
+	// Alice drops and reconnects with her player ID
+	alice.Close()
+	read(bob, "disconnected")
+	alice, _, err = websocket.DefaultDialer.Dial("ws://"+ln.Addr().String()+room.Socket+"?player="+state.PlayerID, nil)
+	assert.NoError(t, err)
+	rejoined := read(alice, "state")
+	assert.Equal(t, race.StatePlaying, rejoined.Room.State)
+	assert.Len(t, rejoined.Room.Guesses, 1)
+	read(bob, "reconnected")
+}
+
+func TestEventStream(t *testing.T) {
+	app := fiber.New(fiberConfig())
+
+	daily, err := utils.NewDailySchedule("", "")
+	assert.NoError(t, err)
+
+	server := &FiberServer{
+		App:     app,
+		db:      database.NewMemory(),
+		daily:   daily,
+		puzzles: testPuzzles,
+		feed:    events.NewBroker(events.DefaultHistory, events.DefaultBuffer),
+		debug:   true,
+	}
+
+	server.RegisterFiberRoutes()
+
+	createGame := func(body string) string {
+		req := httptest.NewRequest("POST", "/games", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req, -1)
+		assert.NoError(t, err)
+		var created response.GameState
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+		return created.ID
+	}
+	guess := func(id, word string) {
+		req := httptest.NewRequest("POST", "/games/"+id+"/guesses", strings.NewReader(`{"guess":"`+word+`"}`))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	}
+
+	id := createGame(`{"size":5,"seed":1}`) // Target "brick"
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/events?games=missing", nil), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+	req := httptest.NewRequest("GET", "/events", nil)
+	req.Header.Set("Last-Event-ID", "soon")
+	resp, err = app.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	go app.Listener(ln)
+	defer app.ShutdownWithTimeout(time.Second)
+
+	type sse struct{ id, event, data string }
+	subscribe := func(lastID string) (*bufio.Reader, func()) {
+		req, _ := http.NewRequest("GET", "http://"+ln.Addr().String()+"/events?games="+id, nil)
+		if lastID != "" {
+			req.Header.Set("Last-Event-ID", lastID)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("GET /events returned error: %v", err)
+		}
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+		return bufio.NewReader(resp.Body), func() { resp.Body.Close() }
+	}
+	read := func(r *bufio.Reader) sse {
+		var e sse
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				t.Fatalf("reading event stream: %v", err)
+			}
+			line = strings.TrimSuffix(line, "\n")
+			switch {
+			case line == "" && e.event != "":
+				return e
+			case strings.HasPrefix(line, "id: "):
+				e.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				e.event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				e.data = strings.TrimPrefix(line, "data: ")
+			}
+		}
+	}
+
+	stream, closeStream := subscribe("")
+	snapshot := read(stream)
+	assert.Equal(t, "solves", snapshot.event)
+	assert.Empty(t, snapshot.id)
+
+	guess(id, "crate")
+	first := read(stream)
+	assert.Equal(t, "game", first.event)
+	assert.NotEmpty(t, first.id)
+	var update events.GameUpdate
+	assert.NoError(t, json.Unmarshal([]byte(first.data), &update))
+	assert.Equal(t, []string{"present", "correct", "absent", "absent", "absent"}, update.Row)
+	assert.NotContains(t, first.data, "crate")
+
+	// Winning today's puzzle updates everyone's counters
+	ws, _ := utils.Lookup("en")
+	_, target, err := daily.Today(ws, 5)
+	assert.NoError(t, err)
+	guess(createGame(`{"daily":true}`), target)
+	solves := read(stream)
+	assert.Equal(t, "solves", solves.event)
+	var results models.DailyResults
+	assert.NoError(t, json.Unmarshal([]byte(solves.data), &results))
+	assert.Equal(t, 1, results.Played)
+	assert.Equal(t, 1, results.Solved)
+	closeStream()
+
+	// A client reconnecting with Last-Event-ID is replayed what it missed,
+	// except for outdated counters: the snapshot after the replay has the
+	// current ones
+	guess(id, "brick")
+	guess(createGame(`{"daily":true}`), target)
+	stream, closeStream = subscribe(first.id)
+	defer closeStream()
+	assert.Equal(t, "game", read(stream).event)
+	solves = read(stream)
+	assert.Equal(t, "solves", solves.event)
+	assert.Empty(t, solves.id)
+	assert.NoError(t, json.Unmarshal([]byte(solves.data), &results))
+	assert.Equal(t, 2, results.Played)
+
+	// Finishing yesterday's puzzle late leaves today's counters alone
+	late := createGame(`{"daily":true}`)
+	g, err := server.db.GetGame(context.Background(), late)
+	assert.NoError(t, err)
+	g.PuzzleNumber--
+	assert.NoError(t, server.db.UpdateGame(context.Background(), g))
+	sub, _ := server.feed.Subscribe(nil, 0)
+	defer sub.Close()
+	guess(late, target)
+	for len(sub.Events()) > 0 {
+		assert.NotEqual(t, events.TypeSolves, (<-sub.Events()).Type)
+	}
+}
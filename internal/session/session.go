@@ -0,0 +1,306 @@
+// Package session runs games independently of the transport. The REST and
+// gRPC APIs both parse their requests into calls on a Service and only map
+// its errors and results to their own wire formats, so the two can never
+// disagree on the rules.
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"Wordle/internal/database"
+	"Wordle/internal/events"
+	"Wordle/internal/game"
+	"Wordle/internal/models"
+	"Wordle/internal/puzzle"
+	"Wordle/internal/response"
+	"Wordle/internal/stats"
+	"Wordle/internal/utils"
+)
+
+var (
+	ErrForbidden   = errors.New("game belongs to another player")
+	ErrWrongLength = errors.New("the length of guess does not match the specified size")
+
+	// The errors below are the server's fault rather than the caller's and
+	// wrap the underlying failure.
+	ErrNoTarget    = errors.New("failed to select a target word")
+	ErrPuzzleToken = errors.New("failed to issue a puzzle token")
+	ErrSave        = errors.New("failed to save game")
+)
+
+// statsSaveAttempts bounds how often recordStats retries after losing a race
+// with another game of the same player finishing at the same time.
+const statsSaveAttempts = 3
+
+// Service creates and plays games against the shared storage, daily
+// schedule and puzzle codec. The event feed is optional.
+type Service struct {
+	db      database.Service
+	daily   *utils.DailySchedule
+	puzzles *puzzle.Codec
+	feed    *events.Broker
+}
+
+func New(db database.Service, daily *utils.DailySchedule, puzzles *puzzle.Codec, feed *events.Broker) *Service {
+	return &Service{
+		db:      db,
+		daily:   daily,
+		puzzles: puzzles,
+		feed:    feed,
+	}
+}
+
+// Start describes a game to create.
+type Start struct {
+	game.Options
+	Daily  bool   // Play today's daily puzzle
+	Puzzle string // Replay the puzzle sealed in a puzzle token
+	UserID string // The owning player, empty for anonymous games
+}
+
+// Start creates and stores a game. Classic games get a puzzle token so the
+// puzzle can be shared and replayed.
+func (s *Service) Start(ctx context.Context, req Start) (*models.Game, error) {
+	opts := req.Options
+	if req.Daily {
+		ws, err := utils.Lookup(opts.Lang)
+		if err != nil {
+			return nil, err
+		}
+		if opts.Size == 0 {
+			opts.Size = game.DefaultSize
+		}
+		opts.PuzzleNumber, opts.Target, err = s.daily.Today(ws, opts.Size)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Puzzle != "" {
+		ref, err := s.puzzles.Decode(req.Puzzle)
+		if err != nil {
+			return nil, err
+		}
+		opts.Lang = ref.Lang
+		opts.Size = utils.WordLength(ref.Word)
+		opts.Target = ref.Word
+	}
+
+	g, err := game.New(opts)
+	if err != nil {
+		return nil, err
+	}
+	// Puzzle tokens seal a single fixed target, which only classic games have
+	if game.Mode(g) == game.ModeClassic {
+		g.PuzzleToken, err = s.puzzles.Encode(puzzle.Ref{Lang: g.Lang, Word: g.Target})
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrPuzzleToken, err)
+		}
+	}
+	g.UserID = req.UserID
+
+	if err := s.db.CreateGame(ctx, g); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSave, err)
+	}
+	return g, nil
+}
+
+// Game loads a game on behalf of userID, who is empty for anonymous callers.
+func (s *Service) Game(ctx context.Context, id, userID string) (*models.Game, error) {
+	g, err := s.db.GetGame(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !CanAccess(g, userID) {
+		return nil, ErrForbidden
+	}
+	return g, nil
+}
+
+// CanAccess reports whether userID may read or play g. Anonymous games are
+// open to anyone holding the ID; owned games are private to their player.
+func CanAccess(g *models.Game, userID string) bool {
+	return g.UserID == "" || g.UserID == userID
+}
+
+// Guess plays word in g and saves the game. A new game is only stored once
+// its first guess was accepted, so rejected guesses never leave empty games
+// behind. Saving fails with an error wrapping ErrSave, and also
+// database.ErrConflict when the game was updated concurrently.
+//
+// Stats, challenge counters and the event feed are updated once the game is
+// saved. Their failures are only logged: the guess has been kept either way.
+func (s *Service) Guess(ctx context.Context, g *models.Game, word string, isNew bool) (*models.Guess, error) {
+	guess, err := game.Play(g, word)
+	if err != nil {
+		return nil, err
+	}
+
+	if isNew {
+		err = s.db.CreateGame(ctx, g)
+	} else {
+		err = s.db.UpdateGame(ctx, g)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSave, err)
+	}
+
+	if game.Finished(g) && g.UserID != "" {
+		if err := s.recordStats(ctx, g); err != nil {
+			log.Printf("failed to record stats for game %s: %v", g.ID, err)
+		}
+	}
+	if g.ChallengeID != "" && (isNew || g.Status == game.StatusWon) {
+		if err := s.recordChallengeResult(ctx, g, isNew); err != nil {
+			log.Printf("failed to record challenge result for game %s: %v", g.ID, err)
+		}
+	}
+	s.announce(ctx, g, guess)
+
+	return guess, nil
+}
+
+// Score describes a single guess scored without a game, as the /random and
+// /daily/ endpoints do.
+type Score struct {
+	Lang   string
+	Guess  string
+	Size   int
+	Seed   int64  // Picks the target; callers decide who may use it
+	Puzzle string // Scores against the puzzle sealed in a token instead
+	Daily  bool   // Scores against the daily word instead
+}
+
+// Score scores a guess against a random word, the daily word or a replayed
+// puzzle. Random and replayed targets come back sealed in a puzzle token so
+// the same puzzle can be played again.
+func (s *Service) Score(req Score) ([]response.LetterFeedback, string, error) {
+	var ref puzzle.Ref
+	if req.Puzzle != "" {
+		var err error
+		if ref, err = s.puzzles.Decode(req.Puzzle); err != nil {
+			return nil, "", err
+		}
+		// The token decides the puzzle; lang and size follow from it
+		req.Lang = ref.Lang
+		req.Size = utils.WordLength(ref.Word)
+	}
+	if req.Size == 0 {
+		req.Size = game.DefaultSize
+	}
+
+	ws, err := utils.Lookup(req.Lang)
+	if err != nil {
+		return nil, "", err
+	}
+	guess := ws.Normalize(req.Guess)
+	if utils.WordLength(guess) != req.Size {
+		return nil, "", ErrWrongLength
+	}
+	if !ws.IsValidWord(guess) {
+		return nil, "", game.ErrInvalidWord
+	}
+
+	target := ref.Word
+	switch {
+	case req.Daily && req.Seed != 0:
+		target, err = ws.DailyWord(req.Size, req.Seed)
+	case req.Daily:
+		_, target, err = s.daily.Today(ws, req.Size)
+	case target == "" && req.Seed != 0:
+		target, err = ws.AnswerAt(req.Size, req.Seed)
+	case target == "":
+		target, err = ws.RandomWord(req.Size)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %w", ErrNoTarget, err)
+	}
+
+	var token string
+	if !req.Daily {
+		token, err = s.puzzles.Encode(puzzle.Ref{Lang: ws.Language.Code, Word: target})
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: %w", ErrPuzzleToken, err)
+		}
+	}
+	return utils.CompareWords(guess, target), token, nil
+}
+
+// recordStats folds a finished game into its player's stats, reloading and
+// retrying when another game of theirs was recorded concurrently.
+func (s *Service) recordStats(ctx context.Context, g *models.Game) error {
+	var err error
+	for attempt := 0; attempt < statsSaveAttempts; attempt++ {
+		st, loadErr := s.db.GetStats(ctx, g.UserID)
+		if errors.Is(loadErr, database.ErrNotFound) {
+			st = &models.Stats{UserID: g.UserID}
+		} else if loadErr != nil {
+			return loadErr
+		}
+
+		if !stats.Record(st, g) {
+			return nil
+		}
+		if err = s.db.SaveStats(ctx, st); !errors.Is(err, database.ErrConflict) {
+			return err
+		}
+	}
+	return err
+}
+
+// recordChallengeResult counts a player's first guess as an attempt and a
+// won game as a solve.
+func (s *Service) recordChallengeResult(ctx context.Context, g *models.Game, isNew bool) error {
+	attempts, solves := 0, 0
+	if isNew {
+		attempts = 1
+	}
+	if g.Status == game.StatusWon {
+		solves = 1
+	}
+	return s.db.IncrementChallenge(ctx, g.ChallengeID, attempts, solves)
+}
+
+// announce publishes a played guess to the game's followers and, once a
+// game of today's daily puzzle finishes, the updated solve counters. Late
+// finishes of older puzzles would overwrite today's counters on the clients.
+func (s *Service) announce(ctx context.Context, g *models.Game, guess *models.Guess) {
+	if s.feed == nil {
+		return
+	}
+
+	update := events.GameUpdate{
+		GameID:            g.ID,
+		Row:               game.Colours(guess.Feedback),
+		Status:            g.Status,
+		RemainingAttempts: game.Remaining(g),
+	}
+	for _, fb := range guess.Boards {
+		var row []string
+		if fb != nil {
+			row = game.Colours(fb)
+		}
+		update.Boards = append(update.Boards, row)
+	}
+	if err := s.feed.Publish(events.TypeGame, g.ID, update); err != nil {
+		log.Printf("failed to publish guess for game %s: %v", g.ID, err)
+	}
+
+	if !game.Finished(g) || g.PuzzleNumber == 0 || g.Seeded || g.Hinted {
+		return
+	}
+	if g.PuzzleNumber != s.daily.PuzzleNumber(time.Now()) {
+		return
+	}
+	results, err := s.db.DailyResults(ctx, g.PuzzleNumber)
+	if err == nil {
+		err = s.feed.Publish(events.TypeSolves, "", results)
+	}
+	if err != nil {
+		log.Printf("failed to publish results for puzzle %d: %v", g.PuzzleNumber, err)
+	}
+}
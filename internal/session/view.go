@@ -0,0 +1,114 @@
+package session
+
+import (
+	"fmt"
+	"time"
+
+	"Wordle/internal/game"
+	"Wordle/internal/models"
+	"Wordle/internal/response"
+	"Wordle/internal/utils"
+)
+
+// State converts a stored game into its public representation,
+// revealing the target only once the game has finished.
+func State(g *models.Game) response.GameState {
+	state := response.GameState{
+		ID:                g.ID,
+		Mode:              game.Mode(g),
+		Lang:              g.Lang,
+		Size:              g.Size,
+		MaxAttempts:       g.MaxAttempts,
+		HardMode:          g.HardMode,
+		PuzzleNumber:      g.PuzzleNumber,
+		PuzzleToken:       g.PuzzleToken,
+		Status:            g.Status,
+		RemainingAttempts: game.Remaining(g),
+		Guesses:           make([]response.GuessRecord, 0, len(g.Guesses)),
+	}
+	for _, guess := range g.Guesses {
+		state.Guesses = append(state.Guesses, response.GuessRecord{
+			Guess:    guess.Word,
+			Feedback: guess.Feedback,
+			Boards:   guess.Boards,
+		})
+	}
+	if game.Finished(g) {
+		state.Target = g.Target
+	}
+	if g.Mode == game.ModeAbsurdle && !game.Finished(g) {
+		state.Candidates = len(g.Candidates)
+	}
+	if game.IsMulti(g) {
+		state.Boards = boardStates(g)
+	}
+	state.Keyboard = Keys(g)
+	return state
+}
+
+func boardStates(g *models.Game) []response.BoardState {
+	boards := make([]response.BoardState, len(g.Targets))
+	for i, target := range g.Targets {
+		boards[i] = response.BoardState{
+			Board:    i,
+			Solved:   g.Solved[i] != 0,
+			SolvedIn: g.Solved[i],
+		}
+		if game.Finished(g) {
+			boards[i].Target = target
+		}
+	}
+	return boards
+}
+
+// Keys lays out the keys of the game's alphabet, or none when its language
+// is no longer loaded.
+func Keys(g *models.Game) []response.KeyState {
+	ws, err := utils.Lookup(g.Lang)
+	if err != nil {
+		return nil
+	}
+	return game.KeyboardState(g, ws.Language.Letters())
+}
+
+// GuessResult describes the outcome of guess, just played in g, revealing
+// the targets only once the game has finished.
+func GuessResult(g *models.Game, guess *models.Guess) response.GameGuessResponse {
+	resp := response.GameGuessResponse{
+		GameID:            g.ID,
+		Feedback:          guess.Feedback,
+		Status:            g.Status,
+		RemainingAttempts: game.Remaining(g),
+	}
+	for i, fb := range guess.Boards {
+		if fb != nil {
+			resp.Boards = append(resp.Boards, response.BoardFeedback{
+				Board:    i,
+				Feedback: fb,
+				Solved:   g.Solved[i] != 0,
+			})
+		}
+	}
+	if game.Finished(g) {
+		resp.Target = g.Target
+		resp.Targets = g.Targets
+	}
+	resp.Keyboard = Keys(g)
+	return resp
+}
+
+// DailyInfo describes the daily puzzle active at now without revealing its
+// answer.
+func (s *Service) DailyInfo(now time.Time) response.DailyInfo {
+	number := s.daily.PuzzleNumber(now)
+	next := s.daily.NextRollover(now)
+
+	return response.DailyInfo{
+		PuzzleNumber:     number,
+		Title:            fmt.Sprintf("Wordle #%d", number),
+		Date:             s.daily.Date(number).Format("2006-01-02"),
+		Timezone:         s.daily.Location.String(),
+		NextRolloverAt:   next,
+		SecondsUntilNext: int64(next.Sub(now).Seconds()),
+	}
+}
@@ -0,0 +1,217 @@
+// Package share renders a finished game as the familiar pasteable result
+// ("Wordle 1234 4/6" followed by rows of coloured squares) and parses such a
+// paste back into feedback rows.
+package share
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"Wordle/internal/game"
+	"Wordle/internal/models"
+	"Wordle/internal/response"
+)
+
+// Styles a result can be rendered in.
+const (
+	StyleStandard     = "standard"      // 🟩🟨⬛
+	StyleHighContrast = "high_contrast" // 🟧🟦⬛
+	StylePlain        = "plain"         // G Y . for clients that cannot show emoji
+)
+
+// DefaultTitle heads every result.
+const DefaultTitle = "Wordle"
+
+var (
+	ErrUnknownStyle = errors.New("unknown share style")
+	ErrInvalidGrid  = errors.New("text is not a shared result grid")
+)
+
+// symbols maps each style to the glyphs for correct, present and absent.
+var symbols = map[string][3]string{
+	StyleStandard:     {"🟩", "🟨", "⬛"},
+	StyleHighContrast: {"🟧", "🟦", "⬛"},
+	StylePlain:        {"G", "Y", "."},
+}
+
+// statuses maps every glyph Parse understands, from any style, back to a
+// status. Light-mode grids use white squares for absent letters.
+var statuses = map[string]string{
+	"🟩": "correct", "🟧": "correct", "G": "correct",
+	"🟨": "present", "🟦": "present", "Y": "present",
+	"⬛": "absent", "⬜": "absent", ".": "absent",
+}
+
+// header matches the first line of a result, e.g. "Wordle 1,234 4/6*". The
+// puzzle number is missing for free-play games and "X" marks a loss.
+var header = regexp.MustCompile(`^(.+?)(?: #?([\d,]+))? ([1-9]\d*|X)/([1-9]\d*)(\*?)$`)
+
+// Result is a game as it appears in a shared grid. Letters are never part of
+// a share, so Rows only carry statuses.
+type Result struct {
+	Title        string
+	PuzzleNumber int // 0 for free-play games
+	MaxAttempts  int
+	Won          bool
+	HardMode     bool
+	Rows         [][]response.LetterFeedback
+}
+
+// FromGame builds the shareable view of a game, dropping the guessed letters.
+func FromGame(g *models.Game) Result {
+	r := Result{
+		Title:        DefaultTitle,
+		PuzzleNumber: g.PuzzleNumber,
+		MaxAttempts:  g.MaxAttempts,
+		Won:          g.Status == game.StatusWon,
+		HardMode:     g.HardMode,
+		Rows:         make([][]response.LetterFeedback, 0, len(g.Guesses)),
+	}
+	for _, guess := range g.Guesses {
+		row := make([]response.LetterFeedback, len(guess.Feedback))
+		for i, fb := range guess.Feedback {
+			row[i].Status = fb.Status
+		}
+		r.Rows = append(r.Rows, row)
+	}
+	return r
+}
+
+// Render formats r in the given style.
+func Render(r Result, style string) (string, error) {
+	glyphs, ok := symbols[style]
+	if !ok {
+		return "", ErrUnknownStyle
+	}
+
+	var b strings.Builder
+	b.WriteString(r.Title)
+	if r.PuzzleNumber != 0 {
+		fmt.Fprintf(&b, " %d", r.PuzzleNumber)
+	}
+	score := "X"
+	if r.Won {
+		score = strconv.Itoa(len(r.Rows))
+	}
+	fmt.Fprintf(&b, " %s/%d", score, r.MaxAttempts)
+	if r.HardMode {
+		b.WriteString("*")
+	}
+	b.WriteString("\n")
+
+	for _, row := range r.Rows {
+		b.WriteString("\n")
+		for _, fb := range row {
+			switch fb.Status {
+			case "correct":
+				b.WriteString(glyphs[0])
+			case "present":
+				b.WriteString(glyphs[1])
+			default:
+				b.WriteString(glyphs[2])
+			}
+		}
+	}
+	return b.String(), nil
+}
+
+// Parse reads a pasted result in any style back into a Result. It checks the
+// grid is consistent with its header: one row per guess, rows of equal width
+// and a fully correct final row exactly when the game was won.
+func Parse(text string) (Result, error) {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) < 2 {
+		return Result{}, ErrInvalidGrid
+	}
+
+	m := header.FindStringSubmatch(lines[0])
+	if m == nil {
+		return Result{}, ErrInvalidGrid
+	}
+	r := Result{
+		Title:    m[1],
+		Won:      m[3] != "X",
+		HardMode: m[5] == "*",
+	}
+	if m[2] != "" {
+		r.PuzzleNumber, _ = strconv.Atoi(strings.ReplaceAll(m[2], ",", ""))
+	}
+	r.MaxAttempts, _ = strconv.Atoi(m[4])
+
+	for _, line := range lines[1:] {
+		row, err := parseRow(line)
+		if err != nil {
+			return Result{}, err
+		}
+		if len(r.Rows) > 0 && len(row) != len(r.Rows[0]) {
+			return Result{}, fmt.Errorf("%w: rows have different widths", ErrInvalidGrid)
+		}
+		r.Rows = append(r.Rows, row)
+	}
+
+	want := r.MaxAttempts
+	if r.Won {
+		want, _ = strconv.Atoi(m[3])
+	}
+	if len(r.Rows) != want || want > r.MaxAttempts {
+		return Result{}, fmt.Errorf("%w: header promises %d rows, grid has %d", ErrInvalidGrid, want, len(r.Rows))
+	}
+	for i, row := range r.Rows {
+		if solved(row) != (r.Won && i == len(r.Rows)-1) {
+			return Result{}, fmt.Errorf("%w: row %d does not match the score", ErrInvalidGrid, i+1)
+		}
+	}
+	return r, nil
+}
+
+// Matches reports whether a parsed result shows exactly the feedback of g.
+func Matches(r Result, g *models.Game) bool {
+	want := FromGame(g)
+	if r.PuzzleNumber != want.PuzzleNumber || r.MaxAttempts != want.MaxAttempts ||
+		r.Won != want.Won || r.HardMode != want.HardMode || len(r.Rows) != len(want.Rows) {
+		return false
+	}
+	for i, row := range r.Rows {
+		if len(row) != len(want.Rows[i]) {
+			return false
+		}
+		for j := range row {
+			if row[j].Status != want.Rows[i][j].Status {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func parseRow(line string) ([]response.LetterFeedback, error) {
+	var row []response.LetterFeedback
+	for _, r := range line {
+		if r == '\uFE0F' || r == ' ' {
+			continue // Emoji presentation selectors and spacing carry no meaning
+		}
+		status, ok := statuses[string(r)]
+		if !ok {
+			return nil, fmt.Errorf("%w: unexpected %q", ErrInvalidGrid, r)
+		}
+		row = append(row, response.LetterFeedback{Status: status})
+	}
+	return row, nil
+}
+
+func solved(row []response.LetterFeedback) bool {
+	for _, fb := range row {
+		if fb.Status != "correct" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,145 @@
+package share
+
+import (
+	"errors"
+	"testing"
+
+	"Wordle/internal/models"
+	"Wordle/internal/utils"
+)
+
+func testGame(status string, hardMode bool, words ...string) *models.Game {
+	g := &models.Game{
+		PuzzleNumber: 1234,
+		MaxAttempts:  6,
+		HardMode:     hardMode,
+		Status:       status,
+	}
+	for _, w := range words {
+		g.Guesses = append(g.Guesses, models.Guess{Word: w, Feedback: utils.CompareWords(w, "brick")})
+	}
+	return g
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name     string
+		game     *models.Game
+		style    string
+		expected string
+	}{
+		{
+			name:     "Standard win",
+			game:     testGame("won", false, "crate", "brick"),
+			style:    StyleStandard,
+			expected: "Wordle 1234 2/6\n\n🟨🟩⬛⬛⬛\n🟩🟩🟩🟩🟩",
+		},
+		{
+			name:     "High contrast hard mode",
+			game:     testGame("won", true, "crate", "brick"),
+			style:    StyleHighContrast,
+			expected: "Wordle 1234 2/6*\n\n🟦🟧⬛⬛⬛\n🟧🟧🟧🟧🟧",
+		},
+		{
+			name:     "Plain loss",
+			game:     testGame("lost", false, "crate", "apple", "crate", "apple", "crate", "apple"),
+			style:    StylePlain,
+			expected: "Wordle 1234 X/6\n\nYG...\n.....\nYG...\n.....\nYG...\n.....",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Render(FromGame(tt.game), tt.style)
+			if err != nil {
+				t.Fatalf("Render() returned error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Render() = %q; want %q", got, tt.expected)
+			}
+		})
+	}
+
+	if _, err := Render(Result{}, "sepia"); !errors.Is(err, ErrUnknownStyle) {
+		t.Errorf("Render(sepia) error = %v; want %v", err, ErrUnknownStyle)
+	}
+}
+
+func TestParseRoundTrip(t *testing.T) {
+	games := []*models.Game{
+		testGame("won", false, "crate", "brick"),
+		testGame("won", true, "brick"),
+		testGame("lost", false, "crate", "apple", "crate", "apple", "crate", "apple"),
+	}
+
+	for _, g := range games {
+		for style := range symbols {
+			text, _ := Render(FromGame(g), style)
+			r, err := Parse(text)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", text, err)
+			}
+			if !Matches(r, g) {
+				t.Errorf("Parse(%q) does not match the rendered game", text)
+			}
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		text        string
+		expectedErr error
+		puzzle      int
+	}{
+		{
+			name:   "Thousands separator, light mode and variation selectors",
+			text:   "Wordle 1,234 2/6\r\n\r\n🟨🟩⬜️⬜️⬜️\r\n🟩🟩🟩🟩🟩\r\n",
+			puzzle: 1234,
+		},
+		{
+			name: "Free play without puzzle number",
+			text: "Wordle 1/6\n🟩🟩🟩🟩🟩",
+		},
+		{
+			name:        "Row count disagrees with score",
+			text:        "Wordle 1234 3/6\n🟨🟩⬛⬛⬛\n🟩🟩🟩🟩🟩",
+			expectedErr: ErrInvalidGrid,
+		},
+		{
+			name:        "Win without a solved row",
+			text:        "Wordle 1234 1/6\n🟨🟩⬛⬛⬛",
+			expectedErr: ErrInvalidGrid,
+		},
+		{
+			name:        "Ragged rows",
+			text:        "Wordle 1234 2/6\n🟨🟩⬛⬛\n🟩🟩🟩🟩🟩",
+			expectedErr: ErrInvalidGrid,
+		},
+		{
+			name:        "Unknown glyph",
+			text:        "Wordle 1234 1/6\n🟩🟩🟥🟩🟩",
+			expectedErr: ErrInvalidGrid,
+		},
+		{
+			name:        "Not a result",
+			text:        "hello there",
+			expectedErr: ErrInvalidGrid,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := Parse(tt.text)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("Parse() error = %v; want %v", err, tt.expectedErr)
+			}
+			if err == nil && r.PuzzleNumber != tt.puzzle {
+				t.Errorf("Parse() puzzle = %d; want %d", r.PuzzleNumber, tt.puzzle)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// Package solver narrows the word list down from guess feedback and ranks the
+// next guess. All scoring goes through utils.CompareWords so the solver can
+// never disagree with the game rules.
+package solver
+
+import (
+	"math"
+	"sort"
+
+	"Wordle/internal/models"
+	"Wordle/internal/response"
+	"Wordle/internal/utils"
+)
+
+const (
+	StrategyEntropy = "entropy"
+	StrategyMinimax = "minimax"
+)
+
+// Suggestion is a ranked next guess.
+type Suggestion struct {
+	Word string
+	// Score is the expected information in bits for the entropy strategy and
+	// the size of the largest remaining bucket for minimax.
+	Score     float64
+	Candidate bool // Whether the word itself could still be the answer
+}
+
+// Pattern encodes a feedback row as a base-3 number (absent=0, present=1,
+// correct=2) so rows can be compared and bucketed cheaply.
+func Pattern(feedback []response.LetterFeedback) int {
+	p := 0
+	for _, fb := range feedback {
+		p *= 3
+		switch fb.Status {
+		case "present":
+			p++
+		case "correct":
+			p += 2
+		}
+	}
+	return p
+}
+
+// Candidates returns the words that are consistent with every guess in history.
+func Candidates(words []string, history []models.Guess) []string {
+	var remaining []string
+	for _, word := range words {
+		if consistent(word, history) {
+			remaining = append(remaining, word)
+		}
+	}
+	return remaining
+}
+
+func consistent(word string, history []models.Guess) bool {
+	for _, h := range history {
+		if utils.WordLength(h.Word) != utils.WordLength(word) {
+			return false
+		}
+		if Pattern(utils.CompareWords(h.Word, word)) != Pattern(h.Feedback) {
+			return false
+		}
+	}
+	return true
+}
+
+// Buckets groups candidates by the feedback pattern a guess would produce.
+func Buckets(guess string, candidates []string) map[int]int {
+	buckets := make(map[int]int)
+	for _, c := range candidates {
+		buckets[Pattern(utils.CompareWords(guess, c))]++
+	}
+	return buckets
+}
+
+// Partition splits candidates by the feedback pattern a guess would produce,
+// keeping the words of each bucket in their original order.
+func Partition(guess string, candidates []string) map[int][]string {
+	buckets := make(map[int][]string)
+	for _, c := range candidates {
+		p := Pattern(utils.CompareWords(guess, c))
+		buckets[p] = append(buckets[p], c)
+	}
+	return buckets
+}
+
+// Suggest ranks every word in pool as the next guess against the remaining
+// candidates and returns the best limit suggestions. Ties prefer words that
+// could still be the answer, then alphabetical order.
+func Suggest(candidates, pool []string, strategy string, limit int) []Suggestion {
+	if len(candidates) == 0 {
+		return []Suggestion{}
+	}
+
+	isCandidate := make(map[string]bool, len(candidates))
+	for _, c := range candidates {
+		isCandidate[c] = true
+	}
+
+	// With one or two candidates left, guessing one of them is always optimal
+	if len(candidates) <= 2 {
+		pool = candidates
+	}
+
+	suggestions := make([]Suggestion, 0, len(pool))
+	for _, word := range pool {
+		buckets := Buckets(word, candidates)
+		suggestions = append(suggestions, Suggestion{
+			Word:      word,
+			Score:     score(buckets, len(candidates), strategy),
+			Candidate: isCandidate[word],
+		})
+	}
+
+	higherIsBetter := strategy != StrategyMinimax
+	sort.Slice(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if a.Score != b.Score {
+			if higherIsBetter {
+				return a.Score > b.Score
+			}
+			return a.Score < b.Score
+		}
+		if a.Candidate != b.Candidate {
+			return a.Candidate
+		}
+		return a.Word < b.Word
+	})
+
+	if limit > 0 && len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions
+}
+
+func score(buckets map[int]int, total int, strategy string) float64 {
+	if strategy == StrategyMinimax {
+		worst := 0
+		for _, n := range buckets {
+			if n > worst {
+				worst = n
+			}
+		}
+		return float64(worst)
+	}
+
+	entropy := 0.0
+	for _, n := range buckets {
+		p := float64(n) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
@@ -0,0 +1,161 @@
+package solver
+
+import (
+	"testing"
+
+	"Wordle/internal/models"
+	"Wordle/internal/response"
+	"Wordle/internal/utils"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var testWords = []string{"apple", "brick", "crate", "grape", "plane", "plate", "slate", "state"}
+
+func history(target string, guesses ...string) []models.Guess {
+	var h []models.Guess
+	for _, g := range guesses {
+		h = append(h, models.Guess{Word: g, Feedback: utils.CompareWords(g, target)})
+	}
+	return h
+}
+
+func TestPattern(t *testing.T) {
+	tests := []struct {
+		name     string
+		feedback []response.LetterFeedback
+		expected int
+	}{
+		{
+			name:     "Empty",
+			feedback: nil,
+			expected: 0,
+		},
+		{
+			name: "All absent",
+			feedback: []response.LetterFeedback{
+				{Letter: "z", Status: "absent"},
+				{Letter: "z", Status: "absent"},
+			},
+			expected: 0,
+		},
+		{
+			name: "Mixed",
+			feedback: []response.LetterFeedback{
+				{Letter: "a", Status: "correct"},
+				{Letter: "b", Status: "present"},
+				{Letter: "c", Status: "absent"},
+			},
+			expected: 2*9 + 1*3 + 0,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Pattern(tt.feedback); got != tt.expected {
+				t.Errorf("Pattern() = %d; want %d", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCandidates(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		guesses  []string
+		expected []string
+	}{
+		{
+			name:     "No history keeps every word",
+			target:   "plate",
+			guesses:  nil,
+			expected: testWords,
+		},
+		{
+			name:     "One guess narrows the list",
+			target:   "plate",
+			guesses:  []string{"slate"},
+			expected: []string{"plate"},
+		},
+		{
+			name:     "Target always survives",
+			target:   "grape",
+			guesses:  []string{"brick"},
+			expected: []string{"grape"},
+		},
+		{
+			name:     "Ambiguous feedback keeps several words",
+			target:   "state",
+			guesses:  []string{"apple"},
+			expected: []string{"crate", "state"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got := Candidates(testWords, history(tt.target, tt.guesses...))
+			if diff := cmp.Diff(tt.expected, got); diff != "" {
+				t.Errorf("Candidates() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	candidates := []string{"crate", "slate", "state"}
+
+	for _, strategy := range []string{StrategyEntropy, StrategyMinimax} {
+		strategy := strategy
+		t.Run(strategy, func(t *testing.T) {
+			suggestions := Suggest(candidates, testWords, strategy, 3)
+			if len(suggestions) != 3 {
+				t.Fatalf("Suggest() returned %d suggestions; want 3", len(suggestions))
+			}
+
+			// The best guess must split the candidates so no two share a bucket
+			best := suggestions[0]
+			for _, n := range Buckets(best.Word, candidates) {
+				if n > 1 {
+					t.Errorf("best suggestion %q leaves a bucket of %d words", best.Word, n)
+				}
+			}
+		})
+	}
+
+	if got := Suggest(nil, testWords, StrategyEntropy, 5); len(got) != 0 {
+		t.Errorf("Suggest() with no candidates = %v; want none", got)
+	}
+
+	got := Suggest([]string{"plate"}, testWords, StrategyEntropy, 5)
+	if len(got) != 1 || got[0].Word != "plate" || !got[0].Candidate {
+		t.Errorf("Suggest() with one candidate = %v; want [plate]", got)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	buckets := Partition("crate", testWords)
+
+	total := 0
+	for pattern, words := range buckets {
+		total += len(words)
+		for _, w := range words {
+			if got := Pattern(utils.CompareWords("crate", w)); got != pattern {
+				t.Errorf("%q is in bucket %d; its pattern is %d", w, pattern, got)
+			}
+		}
+		if len(words) != Buckets("crate", testWords)[pattern] {
+			t.Errorf("bucket %d holds %d words; Buckets() counts %d", pattern, len(words), Buckets("crate", testWords)[pattern])
+		}
+	}
+	if total != len(testWords) {
+		t.Errorf("Partition() kept %d words; want %d", total, len(testWords))
+	}
+
+	want := []string{"plate", "slate", "state"} // Words sharing only the "ate" ending with the guess
+	if diff := cmp.Diff(want, buckets[Pattern(utils.CompareWords("crate", "state"))]); diff != "" {
+		t.Errorf("Partition() bucket mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,72 @@
+// Package stats keeps a player's totals, guess distribution and daily streak
+// up to date as their games finish.
+package stats
+
+import (
+	"time"
+
+	"Wordle/internal/game"
+	"Wordle/internal/models"
+)
+
+// Record folds a finished game into s. Every game counts towards the totals
+// and the guess distribution; only daily games move the streak, which grows
+// when consecutive puzzle numbers are solved and resets on a loss.
+//
+// Only classic games are recorded; seeded and hinted games never are. A daily puzzle
+// is recorded at most once: a game for a puzzle at or before the last
+// recorded one is ignored, so replaying a day cannot pad the stats. Record reports whether s changed.
+func Record(s *models.Stats, g *models.Game) bool {
+	if !game.Finished(g) || g.Seeded || g.Hinted || game.Mode(g) != game.ModeClassic {
+		return false
+	}
+	if g.PuzzleNumber != 0 && g.PuzzleNumber <= s.LastPuzzle {
+		return false
+	}
+
+	s.Played++
+	won := g.Status == game.StatusWon
+	if won {
+		s.Wins++
+		attempts := len(g.Guesses)
+		for len(s.Distribution) < attempts {
+			s.Distribution = append(s.Distribution, 0)
+		}
+		s.Distribution[attempts-1]++
+	}
+
+	if g.PuzzleNumber != 0 {
+		switch {
+		case !won:
+			s.CurrentStreak = 0
+		case s.LastPuzzle == g.PuzzleNumber-1:
+			s.CurrentStreak++
+		default:
+			s.CurrentStreak = 1
+		}
+		s.MaxStreak = max(s.MaxStreak, s.CurrentStreak)
+		s.LastPuzzle = g.PuzzleNumber
+	}
+
+	s.UpdatedAt = time.Now().UTC()
+	return true
+}
+
+// CurrentStreak returns the streak as of puzzle today. A streak survives
+// while today's puzzle is still unplayed, but breaks once a whole day has
+// been missed.
+func CurrentStreak(s *models.Stats, today int) int {
+	if s.LastPuzzle < today-1 {
+		return 0
+	}
+	return s.CurrentStreak
+}
+
+// WinPercentage returns the share of played games that were won, rounded
+// down to a whole percent.
+func WinPercentage(s *models.Stats) int {
+	if s.Played == 0 {
+		return 0
+	}
+	return s.Wins * 100 / s.Played
+}
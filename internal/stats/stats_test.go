@@ -0,0 +1,145 @@
+package stats
+
+import (
+	"reflect"
+	"testing"
+
+	"Wordle/internal/models"
+)
+
+func finished(puzzle int, status string, guesses int) *models.Game {
+	return &models.Game{
+		PuzzleNumber: puzzle,
+		Status:       status,
+		Guesses:      make([]models.Guess, guesses),
+	}
+}
+
+func TestRecord(t *testing.T) {
+	tests := []struct {
+		name          string
+		games         []*models.Game
+		wantPlayed    int
+		wantWins      int
+		wantStreak    int
+		wantMaxStreak int
+		wantDist      []int
+	}{
+		{
+			name:          "consecutive daily wins",
+			games:         []*models.Game{finished(10, "won", 3), finished(11, "won", 4), finished(12, "won", 3)},
+			wantPlayed:    3,
+			wantWins:      3,
+			wantStreak:    3,
+			wantMaxStreak: 3,
+			wantDist:      []int{0, 0, 2, 1},
+		},
+		{
+			name:          "missed day restarts streak",
+			games:         []*models.Game{finished(10, "won", 2), finished(11, "won", 2), finished(13, "won", 2)},
+			wantPlayed:    3,
+			wantWins:      3,
+			wantStreak:    1,
+			wantMaxStreak: 2,
+			wantDist:      []int{0, 3},
+		},
+		{
+			name:          "loss resets streak",
+			games:         []*models.Game{finished(10, "won", 1), finished(11, "lost", 6)},
+			wantPlayed:    2,
+			wantWins:      1,
+			wantStreak:    0,
+			wantMaxStreak: 1,
+			wantDist:      []int{1},
+		},
+		{
+			name:          "free play does not touch streak",
+			games:         []*models.Game{finished(10, "won", 2), finished(0, "lost", 6), finished(11, "won", 5)},
+			wantPlayed:    3,
+			wantWins:      2,
+			wantStreak:    2,
+			wantMaxStreak: 2,
+			wantDist:      []int{0, 1, 0, 0, 1},
+		},
+		{
+			name:          "replayed puzzle is ignored",
+			games:         []*models.Game{finished(10, "lost", 6), finished(10, "won", 1), finished(9, "won", 1)},
+			wantPlayed:    1,
+			wantWins:      0,
+			wantStreak:    0,
+			wantMaxStreak: 0,
+			wantDist:      nil,
+		},
+		{
+			name:          "hinted game is ignored",
+			games:         []*models.Game{finished(10, "won", 2), {PuzzleNumber: 11, Status: "won", Hinted: true, Guesses: make([]models.Guess, 3)}},
+			wantPlayed:    1,
+			wantWins:      1,
+			wantStreak:    1,
+			wantMaxStreak: 1,
+			wantDist:      []int{0, 1},
+		},
+		{
+			name:       "unfinished game is ignored",
+			games:      []*models.Game{finished(10, "in_progress", 2)},
+			wantPlayed: 0,
+			wantDist:   nil,
+		},
+		{
+			name: "other game modes are ignored",
+			games: []*models.Game{
+				{Mode: "dordle", Status: "won", Targets: []string{"crate", "brick"}, Guesses: make([]models.Guess, 4)},
+				{Mode: "absurdle", Status: "won", Guesses: make([]models.Guess, 5)},
+			},
+			wantPlayed: 0,
+			wantDist:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var s models.Stats
+			for _, g := range tt.games {
+				Record(&s, g)
+			}
+			if s.Played != tt.wantPlayed || s.Wins != tt.wantWins {
+				t.Errorf("played/wins = %d/%d; want %d/%d", s.Played, s.Wins, tt.wantPlayed, tt.wantWins)
+			}
+			if s.CurrentStreak != tt.wantStreak || s.MaxStreak != tt.wantMaxStreak {
+				t.Errorf("streak/max = %d/%d; want %d/%d", s.CurrentStreak, s.MaxStreak, tt.wantStreak, tt.wantMaxStreak)
+			}
+			if !reflect.DeepEqual(s.Distribution, tt.wantDist) {
+				t.Errorf("distribution = %v; want %v", s.Distribution, tt.wantDist)
+			}
+		})
+	}
+}
+
+func TestCurrentStreak(t *testing.T) {
+	s := &models.Stats{CurrentStreak: 4, LastPuzzle: 20}
+
+	tests := []struct {
+		today int
+		want  int
+	}{
+		{20, 4}, // Played today
+		{21, 4}, // Today's puzzle not played yet
+		{22, 0}, // Missed puzzle 21
+	}
+
+	for _, tt := range tests {
+		if got := CurrentStreak(s, tt.today); got != tt.want {
+			t.Errorf("CurrentStreak(today=%d) = %d; want %d", tt.today, got, tt.want)
+		}
+	}
+}
+
+func TestWinPercentage(t *testing.T) {
+	if got := WinPercentage(&models.Stats{}); got != 0 {
+		t.Errorf("WinPercentage(empty) = %d; want 0", got)
+	}
+	if got := WinPercentage(&models.Stats{Played: 3, Wins: 2}); got != 66 {
+		t.Errorf("WinPercentage(2/3) = %d; want 66", got)
+	}
+}
@@ -0,0 +1,113 @@
+// utils/daily.go
+package utils
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// DefaultDailyEpoch is the date of puzzle #0, matching the original Wordle.
+const DefaultDailyEpoch = "2021-06-19"
+
+// dailyShuffleSeed fixes the order in which daily words are dealt out so every
+// server instance agrees on the word for a given puzzle number.
+const dailyShuffleSeed = 20210619
+
+// DailySchedule maps calendar days in a timezone to puzzle numbers and words.
+type DailySchedule struct {
+	Location *time.Location
+	Epoch    time.Time // Midnight UTC of the epoch's calendar date
+}
+
+// NewDailySchedule builds a schedule from an IANA timezone name and an epoch
+// date in YYYY-MM-DD form. Empty values fall back to UTC and DefaultDailyEpoch.
+func NewDailySchedule(timezone, epoch string) (*DailySchedule, error) {
+	loc := time.UTC
+	if timezone != "" {
+		var err error
+		loc, err = time.LoadLocation(timezone)
+		if err != nil {
+			return nil, errors.New("invalid daily timezone: " + err.Error())
+		}
+	}
+
+	if epoch == "" {
+		epoch = DefaultDailyEpoch
+	}
+	start, err := time.Parse("2006-01-02", epoch)
+	if err != nil {
+		return nil, errors.New("invalid daily epoch: " + err.Error())
+	}
+
+	return &DailySchedule{
+		Location: loc,
+		Epoch:    start,
+	}, nil
+}
+
+// PuzzleNumber returns the number of the puzzle active at t.
+func (s *DailySchedule) PuzzleNumber(t time.Time) int {
+	y, m, d := t.In(s.Location).Date()
+	// Both dates are taken at midnight UTC so DST transitions cannot skew the day count
+	day := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	return int(day.Sub(s.Epoch).Hours() / 24)
+}
+
+// Date returns the calendar date (at midnight in the schedule's timezone) of a puzzle.
+func (s *DailySchedule) Date(number int) time.Time {
+	y, m, d := s.Epoch.AddDate(0, 0, number).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, s.Location)
+}
+
+// NextRollover returns the moment the puzzle after the one active at t begins.
+func (s *DailySchedule) NextRollover(t time.Time) time.Time {
+	y, m, d := t.In(s.Location).Date()
+	return time.Date(y, m, d+1, 0, 0, 0, 0, s.Location)
+}
+
+// Word returns the daily word of the given size for a puzzle number. Words are
+// dealt from a fixed shuffle of the daily list, so none repeats until every
+// word of that size has been used; each pass through the list gets a new
+// shuffle, which never starts with the word the previous pass ended on.
+func (s *DailySchedule) Word(ws *WordSet, number, size int) (string, error) {
+	filteredWords := ws.Daily.Words(size)
+
+	if len(filteredWords) == 0 {
+		return "", ErrNoWordsOfSize
+	}
+
+	n := len(filteredWords)
+	cycle, index := number/n, number%n
+	if index < 0 {
+		cycle, index = cycle-1, index+n
+	}
+
+	return filteredWords[dailyOrder(n, cycle)[index]], nil
+}
+
+// dailyOrder returns the order in which one pass deals out n daily words. A
+// pass that would start with the previous pass's last word swaps its first
+// two words instead. The swap never moves a last word, so each pass only
+// needs the unswapped shuffle of the one before it. Two words are dealt in
+// the same order every pass, which alternates them.
+func dailyOrder(n, cycle int) []int {
+	if n <= 2 {
+		cycle = 0
+	}
+	order := rand.New(rand.NewSource(dailyShuffleSeed + int64(cycle))).Perm(n)
+	if n > 2 {
+		prev := rand.New(rand.NewSource(dailyShuffleSeed + int64(cycle-1))).Perm(n)
+		if order[0] == prev[n-1] {
+			order[0], order[1] = order[1], order[0]
+		}
+	}
+	return order
+}
+
+// Today returns the active puzzle number and its word for the given size.
+func (s *DailySchedule) Today(ws *WordSet, size int) (int, string, error) {
+	number := s.PuzzleNumber(time.Now())
+	word, err := s.Word(ws, number, size)
+	return number, word, err
+}
@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDailySchedulePuzzleNumber(t *testing.T) {
+	schedule, err := NewDailySchedule("America/New_York", "2024-01-01")
+	if err != nil {
+		t.Fatalf("NewDailySchedule returned error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		at       time.Time
+		expected int
+	}{
+		{
+			name:     "Epoch day",
+			at:       time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			expected: 0,
+		},
+		{
+			name:     "Still the previous day in New York",
+			at:       time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC),
+			expected: 0,
+		},
+		{
+			name:     "Next day in New York",
+			at:       time.Date(2024, 1, 2, 6, 0, 0, 0, time.UTC),
+			expected: 1,
+		},
+		{
+			name:     "Across a DST change",
+			at:       time.Date(2024, 3, 11, 12, 0, 0, 0, time.UTC),
+			expected: 70,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if got := schedule.PuzzleNumber(tt.at); got != tt.expected {
+				t.Errorf("PuzzleNumber(%v) = %d; want %d", tt.at, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDailyScheduleNextRollover(t *testing.T) {
+	schedule, err := NewDailySchedule("Asia/Ho_Chi_Minh", "2024-01-01")
+	if err != nil {
+		t.Fatalf("NewDailySchedule returned error: %v", err)
+	}
+
+	at := time.Date(2024, 5, 1, 20, 0, 0, 0, time.UTC) // 03:00 on May 2nd in Vietnam
+	expected := time.Date(2024, 5, 2, 17, 0, 0, 0, time.UTC)
+
+	if got := schedule.NextRollover(at); !got.Equal(expected) {
+		t.Errorf("NextRollover(%v) = %v; want %v", at, got, expected)
+	}
+	if got := schedule.PuzzleNumber(schedule.Date(10)); got != 10 {
+		t.Errorf("PuzzleNumber(Date(10)) = %d; want 10", got)
+	}
+}
+
+func TestDailyScheduleWord(t *testing.T) {
+	// Setup: Initialize the English daily list with sample data
+	defaultWordSet().Daily = NewDictionary([]string{"sunny", "cloudy", "rainy", "stormy", "windy", "foggy"})
+
+	schedule, err := NewDailySchedule("", "")
+	if err != nil {
+		t.Fatalf("NewDailySchedule returned error: %v", err)
+	}
+
+	// Every word of a size is used exactly once per cycle
+	seen := map[string]bool{}
+	for number := 0; number < 4; number++ {
+		word, err := schedule.Word(defaultWordSet(), number, 5)
+		if err != nil {
+			t.Fatalf("Word(%d, 5) returned error: %v", number, err)
+		}
+		if seen[word] {
+			t.Errorf("Word(%d, 5) = %q repeats before the list is exhausted", number, word)
+		}
+		seen[word] = true
+	}
+
+	// The same puzzle number always yields the same word
+	first, _ := schedule.Word(defaultWordSet(), 42, 5)
+	second, _ := schedule.Word(defaultWordSet(), 42, 5)
+	if first != second {
+		t.Errorf("Word(42, 5) is not stable: %q then %q", first, second)
+	}
+
+	if _, err := schedule.Word(defaultWordSet(), -3, 5); err != nil {
+		t.Errorf("Word(-3, 5) returned error: %v", err)
+	}
+
+	// No word comes up two days running, not even where one pass through the
+	// list ends and the next begins
+	for _, size := range []int{5, 6} {
+		prev, _ := schedule.Word(defaultWordSet(), -20, size)
+		for number := -19; number < 200; number++ {
+			word, err := schedule.Word(defaultWordSet(), number, size)
+			if err != nil {
+				t.Fatalf("Word(%d, %d) returned error: %v", number, size, err)
+			}
+			if word == prev {
+				t.Errorf("Word(%d, %d) = %q repeats the previous day's word", number, size, word)
+			}
+			prev = word
+		}
+	}
+
+	if _, err := schedule.Word(defaultWordSet(), 0, 9); err == nil {
+		t.Errorf("Expected error for daily size 9, but got none")
+	}
+}
+
+func TestNewDailyScheduleInvalid(t *testing.T) {
+	if _, err := NewDailySchedule("Not/AZone", ""); err == nil {
+		t.Errorf("Expected error for invalid timezone, but got none")
+	}
+	if _, err := NewDailySchedule("", "01/01/2024"); err == nil {
+		t.Errorf("Expected error for invalid epoch, but got none")
+	}
+}
@@ -0,0 +1,130 @@
+// utils/dictionary.go
+package utils
+
+import (
+	"errors"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrWordExists   = errors.New("word already exists in the list")
+	ErrWordNotFound = errors.New("word is not in the list")
+)
+
+// Dictionary is a word list indexed by length with a hash set for membership
+// checks. It is safe for concurrent use. Removals rebuild the affected slices
+// instead of modifying them, so slices handed out by Words stay valid after
+// later writes.
+type Dictionary struct {
+	mu       sync.RWMutex
+	words    []string
+	byLength map[int][]string
+	set      map[string]struct{}
+}
+
+// NewDictionary builds a dictionary from words, lowercasing them and skipping
+// blanks and duplicates.
+func NewDictionary(words []string) *Dictionary {
+	d := &Dictionary{
+		byLength: make(map[int][]string),
+		set:      make(map[string]struct{}, len(words)),
+	}
+	for _, word := range words {
+		d.insert(word)
+	}
+	return d
+}
+
+func (d *Dictionary) insert(word string) bool {
+	word = strings.ToLower(strings.TrimSpace(word))
+	if word == "" {
+		return false
+	}
+	if _, ok := d.set[word]; ok {
+		return false
+	}
+	size := WordLength(word)
+	d.set[word] = struct{}{}
+	d.words = append(d.words, word)
+	d.byLength[size] = append(d.byLength[size], word)
+	return true
+}
+
+// Add inserts a word, returning ErrWordExists if it is already present.
+func (d *Dictionary) Add(word string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.insert(word) {
+		return ErrWordExists
+	}
+	return nil
+}
+
+// Remove deletes a word, returning ErrWordNotFound if it is not present.
+func (d *Dictionary) Remove(word string) error {
+	word = strings.ToLower(strings.TrimSpace(word))
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, ok := d.set[word]; !ok {
+		return ErrWordNotFound
+	}
+	delete(d.set, word)
+	d.words = without(d.words, word)
+	size := WordLength(word)
+	d.byLength[size] = without(d.byLength[size], word)
+	return nil
+}
+
+// without returns a new slice holding every element of words except word.
+func without(words []string, word string) []string {
+	kept := make([]string, 0, len(words))
+	for _, w := range words {
+		if w != word {
+			kept = append(kept, w)
+		}
+	}
+	return kept
+}
+
+// Contains reports whether the word is in the dictionary, ignoring case.
+func (d *Dictionary) Contains(word string) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	_, ok := d.set[strings.ToLower(word)]
+	return ok
+}
+
+// Words returns the words of the given length in insertion order. The slice
+// is shared with the dictionary and must not be modified.
+func (d *Dictionary) Words(size int) []string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.byLength[size]
+}
+
+// All returns a copy of every word in insertion order.
+func (d *Dictionary) All() []string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return append([]string(nil), d.words...)
+}
+
+// Len returns the number of words in the dictionary.
+func (d *Dictionary) Len() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return len(d.words)
+}
+
+// Sizes returns the number of words for each word length.
+func (d *Dictionary) Sizes() map[int]int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	sizes := make(map[int]int, len(d.byLength))
+	for size, words := range d.byLength {
+		sizes[size] = len(words)
+	}
+	return sizes
+}
@@ -0,0 +1,160 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestDictionary(t *testing.T) {
+	d := NewDictionary([]string{"Apple", "grape", " melon ", "", "apple", "banana"})
+
+	if got := d.Len(); got != 4 {
+		t.Errorf("Len() = %d; want 4", got)
+	}
+
+	tests := []struct {
+		word     string
+		expected bool
+	}{
+		{word: "apple", expected: true},
+		{word: "MELON", expected: true},
+		{word: "kiwi", expected: false},
+		{word: "", expected: false},
+	}
+	for _, tt := range tests {
+		if got := d.Contains(tt.word); got != tt.expected {
+			t.Errorf("Contains(%q) = %v; want %v", tt.word, got, tt.expected)
+		}
+	}
+
+	if got := strings.Join(d.Words(5), ","); got != "apple,grape,melon" {
+		t.Errorf("Words(5) = %q; want %q", got, "apple,grape,melon")
+	}
+	if got := d.Words(9); len(got) != 0 {
+		t.Errorf("Words(9) = %v; want none", got)
+	}
+
+	if err := d.Add("Kiwi"); err != nil {
+		t.Errorf("Add(Kiwi) returned error: %v", err)
+	}
+	if err := d.Add("kiwi"); !errors.Is(err, ErrWordExists) {
+		t.Errorf("Add(kiwi) error = %v; want %v", err, ErrWordExists)
+	}
+	if sizes := d.Sizes(); sizes[4] != 1 || sizes[5] != 3 || sizes[6] != 1 {
+		t.Errorf("Sizes() = %v; want map[4:1 5:3 6:1]", sizes)
+	}
+}
+
+func TestDictionaryConcurrentAccess(t *testing.T) {
+	d := NewDictionary([]string{"apple"})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				d.Add(fmt.Sprintf("w%dx%d", i, j))
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				d.Contains("apple")
+				_ = d.Words(5)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := d.Len(); got != 801 {
+		t.Errorf("Len() after concurrent adds = %d; want 801", got)
+	}
+}
+
+// benchmarkWords builds a synthetic word list of n words with lengths 3 to 15.
+func benchmarkWords(n int) []string {
+	words := make([]string, n)
+	for i := range words {
+		size := 3 + i%13
+		w := []byte(fmt.Sprintf("%0*d", size, i))
+		for k := range w {
+			w[k] = 'a' + (w[k]-'0')%26
+		}
+		words[i] = string(w)
+	}
+	return words
+}
+
+// linearContains and linearFilter reproduce the scans the dictionary replaced
+// and serve as the baseline in the benchmarks below.
+func linearContains(words []string, word string) bool {
+	word = strings.ToLower(word)
+	for _, w := range words {
+		if w == word {
+			return true
+		}
+	}
+	return false
+}
+
+func linearFilter(words []string, size int) []string {
+	var filtered []string
+	for _, w := range words {
+		if len(w) == size {
+			filtered = append(filtered, w)
+		}
+	}
+	return filtered
+}
+
+func BenchmarkLinearContains(b *testing.B) {
+	words := benchmarkWords(50000)
+	target := words[len(words)-1]
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		linearContains(words, target)
+	}
+}
+
+func BenchmarkDictionaryContains(b *testing.B) {
+	words := benchmarkWords(50000)
+	d := NewDictionary(words)
+	target := words[len(words)-1]
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d.Contains(target)
+	}
+}
+
+func BenchmarkLinearFilterBySize(b *testing.B) {
+	words := benchmarkWords(50000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		linearFilter(words, 5)
+	}
+}
+
+func BenchmarkDictionaryWordsBySize(b *testing.B) {
+	d := NewDictionary(benchmarkWords(50000))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d.Words(5)
+	}
+}
+
+func BenchmarkDictionaryContainsParallel(b *testing.B) {
+	words := benchmarkWords(50000)
+	d := NewDictionary(words)
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			d.Contains(words[i%len(words)])
+			i++
+		}
+	})
+}
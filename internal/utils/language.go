@@ -0,0 +1,91 @@
+// utils/language.go
+package utils
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Language describes how a word list spells words: which letters are distinct
+// and which diacritics are folded away before words are compared.
+type Language struct {
+	Code string
+	Name string
+	// Alphabet lists every distinct letter in keyboard order. A precomposed
+	// letter such as "ä" or "ñ" listed here is never folded to its base letter.
+	Alphabet string
+	// FoldMarks lists the combining marks that are stripped during
+	// normalisation, e.g. the acute accent in Spanish or the tones in Vietnamese.
+	FoldMarks string
+}
+
+var languages = []*Language{
+	{
+		Code:     "en",
+		Name:     "English",
+		Alphabet: "abcdefghijklmnopqrstuvwxyz",
+	},
+	{
+		// Umlauts and ß are letters of their own in German word games
+		Code:     "de",
+		Name:     "Deutsch",
+		Alphabet: "abcdefghijklmnopqrstuvwxyzäöüß",
+	},
+	{
+		// ñ is a distinct letter; accents and the diaeresis are ignored
+		Code:      "es",
+		Name:      "Español",
+		Alphabet:  "abcdefghijklmnñopqrstuvwxyz",
+		FoldMarks: "\u0301\u0308", // Acute accent, diaeresis
+	},
+	{
+		// Vowel letters with a breve, circumflex or horn are distinct; the five
+		// tone marks are ignored
+		Code:      "vi",
+		Name:      "Tiếng Việt",
+		Alphabet:  "aăâbcdđeêghiklmnoôơpqrstuưvxy",
+		FoldMarks: "\u0300\u0301\u0303\u0309\u0323", // Huyền, sắc, ngã, hỏi, nặng
+	},
+}
+
+// Normalize lowercases a word, composes it to NFC and strips the language's
+// folded diacritics, so equivalent spellings compare equal rune by rune.
+func (l *Language) Normalize(word string) string {
+	word = strings.ToLower(strings.TrimSpace(word))
+	if l.FoldMarks == "" {
+		return norm.NFC.String(word)
+	}
+
+	decomposed := norm.NFD.String(word)
+	var b strings.Builder
+	b.Grow(len(decomposed))
+	for _, r := range decomposed {
+		if !strings.ContainsRune(l.FoldMarks, r) {
+			b.WriteRune(r)
+		}
+	}
+	return norm.NFC.String(b.String())
+}
+
+// IsWord reports whether a normalised word consists only of the language's letters.
+func (l *Language) IsWord(word string) bool {
+	if word == "" {
+		return false
+	}
+	for _, r := range word {
+		if !strings.ContainsRune(l.Alphabet, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// Letters returns the alphabet as individual letters in keyboard order.
+func (l *Language) Letters() []string {
+	letters := make([]string, 0, len(l.Alphabet))
+	for _, r := range l.Alphabet {
+		letters = append(letters, string(r))
+	}
+	return letters
+}
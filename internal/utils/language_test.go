@@ -0,0 +1,98 @@
+package utils
+
+import "testing"
+
+func TestLanguageNormalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		lang     string
+		word     string
+		expected string
+	}{
+		{name: "English lowercases", lang: "en", word: " Apple ", expected: "apple"},
+		{name: "German keeps umlauts", lang: "de", word: "GRÖßE", expected: "größe"},
+		{name: "German composes decomposed umlauts", lang: "de", word: "gro\u0308\u00dfe", expected: "größe"},
+		{name: "Spanish folds accents", lang: "es", word: "Árbol", expected: "arbol"},
+		{name: "Spanish keeps ñ", lang: "es", word: "SEÑOR", expected: "señor"},
+		{name: "Spanish keeps decomposed ñ", lang: "es", word: "sen\u0303or", expected: "señor"},
+		{name: "Spanish folds diaeresis", lang: "es", word: "pingüino", expected: "pinguino"},
+		{name: "Vietnamese folds tones", lang: "vi", word: "người", expected: "ngươi"},
+		{name: "Vietnamese keeps circumflex under a tone", lang: "vi", word: "nhiều", expected: "nhiêu"},
+		{name: "Vietnamese keeps đ", lang: "vi", word: "Được", expected: "đươc"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			ws, err := Lookup(tt.lang)
+			if err != nil {
+				t.Fatalf("Lookup(%q) returned error: %v", tt.lang, err)
+			}
+			if got := ws.Normalize(tt.word); got != tt.expected {
+				t.Errorf("Normalize(%q) = %q; want %q", tt.word, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLanguageIsWord(t *testing.T) {
+	tests := []struct {
+		lang     string
+		word     string
+		expected bool
+	}{
+		{lang: "en", word: "apple", expected: true},
+		{lang: "en", word: "größe", expected: false},
+		{lang: "en", word: "", expected: false},
+		{lang: "de", word: "größe", expected: true},
+		{lang: "de", word: "señor", expected: false},
+		{lang: "es", word: "señor", expected: true},
+		{lang: "vi", word: "ngươi", expected: true},
+		{lang: "vi", word: "fjord", expected: false},
+	}
+
+	for _, tt := range tests {
+		ws, err := Lookup(tt.lang)
+		if err != nil {
+			t.Fatalf("Lookup(%q) returned error: %v", tt.lang, err)
+		}
+		if got := ws.Language.IsWord(tt.word); got != tt.expected {
+			t.Errorf("%s IsWord(%q) = %v; want %v", tt.lang, tt.word, got, tt.expected)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	// Reload the embedded lists in case earlier tests replaced them
+	LoadWords()
+	LoadDailyWords()
+
+	for _, lang := range []string{"", "en", "de", "es", "vi", "DE"} {
+		ws, err := Lookup(lang)
+		if err != nil {
+			t.Errorf("Lookup(%q) returned error: %v", lang, err)
+			continue
+		}
+		if ws.Daily.Len() == 0 {
+			t.Errorf("Lookup(%q) has an empty daily list", lang)
+		}
+		// Every daily answer must be accepted as a guess
+		for _, word := range ws.Daily.All() {
+			if !ws.IsValidWord(word) {
+				t.Errorf("%s daily word %q is not a valid guess", ws.Language.Code, word)
+			}
+			if !ws.Language.IsWord(word) {
+				t.Errorf("%s daily word %q uses letters outside the alphabet", ws.Language.Code, word)
+			}
+		}
+	}
+
+	if _, err := Lookup("xx"); err != ErrUnknownLanguage {
+		t.Errorf("Lookup(xx) error = %v; want %v", err, ErrUnknownLanguage)
+	}
+
+	es, _ := Lookup("es")
+	if !es.IsValidWord("ÁRBOL") {
+		t.Errorf("es IsValidWord(ÁRBOL) = false; want true")
+	}
+}
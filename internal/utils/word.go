@@ -1,32 +1,68 @@
 package utils
 
-import "Wordle/internal/response"
+import (
+	"unicode"
 
-// CompareWords compares the guess to the target word and returns feedback for each letter
+	"Wordle/internal/response"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Letters splits a word into the letters a player sees. The word is composed
+// to NFC first, and any combining mark that has no precomposed form stays
+// attached to the letter before it, so "é" is one letter however it was typed.
+func Letters(word string) []string {
+	word = norm.NFC.String(word)
+
+	letters := make([]string, 0, len(word))
+	for _, r := range word {
+		if len(letters) > 0 && isCombining(r) {
+			letters[len(letters)-1] += string(r)
+			continue
+		}
+		letters = append(letters, string(r))
+	}
+	return letters
+}
+
+// WordLength returns the number of letters in a word as counted by Letters.
+func WordLength(word string) int {
+	return len(Letters(word))
+}
+
+func isCombining(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc)
+}
+
+// CompareWords compares the guess to the target word and returns feedback for each letter.
+// Both words must have the same number of letters; CompareWords panics otherwise.
 func CompareWords(guess, target string) []response.LetterFeedback {
-	feedback := make([]response.LetterFeedback, len(guess))
-	targetRunes := []rune(target)
-	guessRunes := []rune(guess)
+	guessLetters := Letters(guess)
+	targetLetters := Letters(target)
+	if len(guessLetters) != len(targetLetters) {
+		panic("CompareWords: guess and target must have the same number of letters")
+	}
 
-	matched := make([]bool, len(targetRunes))
+	feedback := make([]response.LetterFeedback, len(guessLetters))
+	matched := make([]bool, len(targetLetters))
 
-	for i := 0; i < len(guessRunes); i++ {
-		if guessRunes[i] == targetRunes[i] {
+	for i := 0; i < len(guessLetters); i++ {
+		if guessLetters[i] == targetLetters[i] {
 			feedback[i] = response.LetterFeedback{
-				Letter: string(guessRunes[i]),
+				Letter: guessLetters[i],
 				Status: "correct",
 			}
 			matched[i] = true
 		}
 	}
 
-	for i := 0; i < len(guessRunes); i++ {
+	for i := 0; i < len(guessLetters); i++ {
 		if feedback[i].Status == "correct" {
 			continue
 		}
 		found := false
-		for j := 0; j < len(targetRunes); j++ {
-			if !matched[j] && guessRunes[i] == targetRunes[j] {
+		for j := 0; j < len(targetLetters); j++ {
+			if !matched[j] && guessLetters[i] == targetLetters[j] {
 				found = true
 				matched[j] = true
 				break
@@ -34,12 +70,12 @@ func CompareWords(guess, target string) []response.LetterFeedback {
 		}
 		if found {
 			feedback[i] = response.LetterFeedback{
-				Letter: string(guessRunes[i]),
+				Letter: guessLetters[i],
 				Status: "present",
 			}
 		} else {
 			feedback[i] = response.LetterFeedback{
-				Letter: string(guessRunes[i]),
+				Letter: guessLetters[i],
 				Status: "absent",
 			}
 		}
@@ -147,3 +147,147 @@ func TestCompareWords(t *testing.T) {
 		})
 	}
 }
+
+// TestCompareWordsUnicode tests CompareWords with accented and multi-byte words.
+func TestCompareWordsUnicode(t *testing.T) {
+	tests := []struct {
+		name     string
+		guess    string
+		target   string
+		expected []response.LetterFeedback
+	}{
+		{
+			name:   "German umlaut and sharp s",
+			guess:  "größe",
+			target: "grüße",
+			expected: []response.LetterFeedback{
+				{Letter: "g", Status: "correct"},
+				{Letter: "r", Status: "correct"},
+				{Letter: "ö", Status: "absent"},
+				{Letter: "ß", Status: "correct"},
+				{Letter: "e", Status: "correct"},
+			},
+		},
+		{
+			name:   "Decomposed guess against composed target",
+			guess:  "gro\u0308\u00dfe",
+			target: "größe",
+			expected: []response.LetterFeedback{
+				{Letter: "g", Status: "correct"},
+				{Letter: "r", Status: "correct"},
+				{Letter: "ö", Status: "correct"},
+				{Letter: "ß", Status: "correct"},
+				{Letter: "e", Status: "correct"},
+			},
+		},
+		{
+			name:   "Accented letter is distinct from its base letter",
+			guess:  "cafe",
+			target: "café",
+			expected: []response.LetterFeedback{
+				{Letter: "c", Status: "correct"},
+				{Letter: "a", Status: "correct"},
+				{Letter: "f", Status: "correct"},
+				{Letter: "e", Status: "absent"},
+			},
+		},
+		{
+			name:   "Spanish ñ present elsewhere",
+			guess:  "ñandu",
+			target: "señor",
+			expected: []response.LetterFeedback{
+				{Letter: "ñ", Status: "present"},
+				{Letter: "a", Status: "absent"},
+				{Letter: "n", Status: "absent"},
+				{Letter: "d", Status: "absent"},
+				{Letter: "u", Status: "absent"},
+			},
+		},
+		{
+			name:     "Different letter counts",
+			guess:    "ngươi",
+			target:   "đươc",
+			expected: nil, // Expect a panic
+		},
+		{
+			name:   "Vietnamese repeated modified vowels",
+			guess:  "ươươi",
+			target: "ngươi",
+			expected: []response.LetterFeedback{
+				{Letter: "ư", Status: "absent"},
+				{Letter: "ơ", Status: "absent"},
+				{Letter: "ư", Status: "correct"},
+				{Letter: "ơ", Status: "correct"},
+				{Letter: "i", Status: "correct"},
+			},
+		},
+		{
+			name:   "Three-byte CJK characters",
+			guess:  "語本日",
+			target: "日本語",
+			expected: []response.LetterFeedback{
+				{Letter: "語", Status: "present"},
+				{Letter: "本", Status: "correct"},
+				{Letter: "日", Status: "present"},
+			},
+		},
+		{
+			name:   "Combining mark without a precomposed form stays on its letter",
+			guess:  "a\u0323\u0304b",
+			target: "ba\u0323\u0304",
+			expected: []response.LetterFeedback{
+				{Letter: "\u1ea1\u0304", Status: "present"},
+				{Letter: "b", Status: "present"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expected == nil {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("CompareWords(%q, %q) did not panic, but expected a panic", tt.guess, tt.target)
+					}
+				}()
+				_ = CompareWords(tt.guess, tt.target)
+				return
+			}
+
+			result := CompareWords(tt.guess, tt.target)
+			if diff := cmp.Diff(tt.expected, result); diff != "" {
+				t.Errorf("CompareWords(%q, %q) mismatch (-want +got):\n%s", tt.guess, tt.target, diff)
+			}
+		})
+	}
+}
+
+// TestWordLength tests that lengths count letters rather than bytes or code points.
+func TestWordLength(t *testing.T) {
+	tests := []struct {
+		name     string
+		word     string
+		expected int
+	}{
+		{name: "ASCII", word: "apple", expected: 5},
+		{name: "Composed umlauts", word: "größe", expected: 5},
+		{name: "Decomposed umlaut", word: "gro\u0308\u00dfe", expected: 5},
+		{name: "Spanish tilde", word: "señor", expected: 5},
+		{name: "Vietnamese with tones", word: "người", expected: 5},
+		{name: "Vietnamese decomposed", word: "ngu\u031bo\u031b\u0300i", expected: 5},
+		{name: "CJK", word: "日本語", expected: 3},
+		{name: "Mark without precomposed form", word: "a\u0323\u0304b", expected: 2},
+		{name: "Leading combining mark", word: "\u0301a", expected: 2},
+		{name: "Empty", word: "", expected: 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WordLength(tt.word); got != tt.expected {
+				t.Errorf("WordLength(%q) = %d; want %d", tt.word, got, tt.expected)
+			}
+		})
+	}
+}
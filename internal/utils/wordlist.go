@@ -5,175 +5,292 @@ import (
 	"bufio"
 	"embed"
 	"errors"
+	"fmt"
 	"math/rand"
-	"os"
-	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 )
 
-//go:embed words.txt
-var wordFile embed.FS
+// DefaultLanguage is used whenever a request does not name a language.
+const DefaultLanguage = "en"
 
-var wordList []string
+var (
+	ErrUnknownLanguage = errors.New("unsupported language")
+	ErrNoWordsOfSize   = errors.New("no words found with the specified size")
+	ErrNotEnoughWords  = errors.New("not enough distinct words with the specified size")
+	ErrEmptyWord       = errors.New("word cannot be empty")
+	ErrForeignLetters  = errors.New("word must contain only alphabetic characters")
+	ErrUnknownTier     = errors.New("unknown word tier")
+)
+
+// Word tiers: answers can be picked as targets, guesses are only accepted as guesses.
+const (
+	TierAnswer = "answer"
+	TierGuess  = "guess"
+)
+
+// Every language has its own directory holding words.txt, the allowed
+// guesses, answers.txt, the curated answers, and daily.txt, the daily answers.
+//
+//go:embed wordlists
+var wordlistFiles embed.FS
+
+// WordSet is the word lists of a single language. Answers and Daily are
+// always subsets of Words.
+type WordSet struct {
+	Language *Language
+	Words    *Dictionary // Every valid guess, answers included
+	Answers  *Dictionary // Targets for random puzzles and games
+	Daily    *Dictionary // Candidate answers for the daily puzzle
+}
 
-//go:embed daily.txt
-var dailyFile embed.FS
+var wordSets = map[string]*WordSet{}
 
-var dailyList []string
+var defaultDailySchedule, _ = NewDailySchedule("", "")
 
 func init() {
 	LoadWords()
 	LoadDailyWords()
 }
 
-func LoadWords() {
-	file, err := wordFile.Open("words.txt")
-	if err != nil {
-		panic("Failed to open words.txt: " + err.Error())
+// Lookup returns the word set for a language code; an empty code selects DefaultLanguage.
+func Lookup(lang string) (*WordSet, error) {
+	if lang == "" {
+		lang = DefaultLanguage
 	}
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		word := strings.TrimSpace(scanner.Text())
-		if word != "" {
-			wordList = append(wordList, strings.ToLower(word))
-		}
+	ws, ok := wordSets[strings.ToLower(lang)]
+	if !ok {
+		return nil, ErrUnknownLanguage
 	}
+	return ws, nil
+}
 
-	if err := scanner.Err(); err != nil {
-		panic("Failed to read words.txt: " + err.Error())
+// Languages returns the codes of every loaded language in alphabetical order.
+func Languages() []string {
+	codes := make([]string, 0, len(wordSets))
+	for code := range wordSets {
+		codes = append(codes, code)
 	}
+	sort.Strings(codes)
+	return codes
 }
 
-func GetRandomWord(size int, seed int64) (string, error) {
-	var filteredWords []string
-	for _, word := range wordList {
-		if len(word) == size {
-			filteredWords = append(filteredWords, word)
-		}
-	}
+func defaultWordSet() *WordSet {
+	return wordSets[DefaultLanguage]
+}
 
-	if len(filteredWords) == 0 {
-		return "", errors.New("no words found with the specified size")
+func LoadWords() {
+	for _, lang := range languages {
+		ws := wordSets[lang.Code]
+		if ws == nil {
+			ws = &WordSet{Language: lang, Daily: NewDictionary(nil)}
+			wordSets[lang.Code] = ws
+		}
+		ws.Words = NewDictionary(readWords(lang, lang.Code+"/words.txt"))
+		ws.Answers = NewDictionary(readWords(lang, lang.Code+"/answers.txt"))
+		ws.mergeAnswers(ws.Daily)
 	}
+}
 
-	if seed >= 0 && int(seed) < len(filteredWords) {
-		return filteredWords[seed], nil
-	}
-	var rng *rand.Rand
-	if seed != 0 {
-		rng = rand.New(rand.NewSource(seed))
-	} else {
-		rng = rand.New(rand.NewSource(time.Now().UnixNano()))
-	}
-	randomIndex := rng.Intn(len(filteredWords))
-	if randomIndex < 0 || randomIndex >= len(filteredWords) {
-		randomIndex = 0
-	} else {
-		return filteredWords[randomIndex], nil
+func LoadDailyWords() {
+	for _, lang := range languages {
+		ws := wordSets[lang.Code]
+		ws.Daily = NewDictionary(readWords(lang, lang.Code+"/daily.txt"))
+		ws.mergeAnswers(ws.Daily)
 	}
-
-	return filteredWords[randomIndex], nil
 }
 
-func IsValidWord(word string) bool {
-	word = strings.ToLower(word)
-	for _, w := range wordList {
-		if w == word {
-			return true
-		}
+// mergeAnswers makes every daily word an answer and every answer a valid
+// guess. Daily words come first so they keep their position in Answers.
+func (ws *WordSet) mergeAnswers(daily *Dictionary) {
+	answers := append(daily.All(), ws.Answers.All()...)
+	ws.Answers = NewDictionary(answers)
+	for _, word := range answers {
+		ws.Words.Add(word)
 	}
-	return false
 }
 
-func LoadDailyWords() {
-	file, err := dailyFile.Open("daily.txt")
+// readWords reads one word per line from an embedded word list, normalised
+// for the language.
+func readWords(lang *Language, name string) []string {
+	file, err := wordlistFiles.Open("wordlists/" + name)
 	if err != nil {
-		panic("Failed to open daily.txt: " + err.Error())
+		panic("Failed to open " + name + ": " + err.Error())
 	}
 	defer file.Close()
 
+	var words []string
 	scanner := bufio.NewScanner(file)
 	for scanner.Scan() {
-		word := strings.TrimSpace(scanner.Text())
+		word := lang.Normalize(scanner.Text())
 		if word != "" {
-			dailyList = append(dailyList, strings.ToLower(word))
+			words = append(words, word)
 		}
 	}
 
 	if err := scanner.Err(); err != nil {
-		panic("Failed to read words.txt: " + err.Error())
+		panic("Failed to read " + name + ": " + err.Error())
 	}
+	return words
 }
 
-func GetDailyWord(size int, seed int64) (string, error) {
-	var rng *rand.Rand
-	if seed != 0 {
-		rng = rand.New(rand.NewSource(seed))
-	} else {
-		rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+// Normalize normalises a word using the language's spelling rules.
+func (ws *WordSet) Normalize(word string) string {
+	return ws.Language.Normalize(word)
+}
+
+// IsValidWord reports whether a word, in any accepted spelling, is a valid guess.
+func (ws *WordSet) IsValidWord(word string) bool {
+	return ws.Words.Contains(ws.Normalize(word))
+}
+
+// WordsOfSize returns a copy of every valid guess with the given length.
+func (ws *WordSet) WordsOfSize(size int) []string {
+	return append([]string(nil), ws.Words.Words(size)...)
+}
+
+// AnswersOfSize returns a copy of every possible answer with the given length.
+func (ws *WordSet) AnswersOfSize(size int) []string {
+	return append([]string(nil), ws.Answers.Words(size)...)
+}
+
+// Tier returns TierAnswer or TierGuess for a valid word, or "" for an unknown one.
+func (ws *WordSet) Tier(word string) string {
+	word = ws.Normalize(word)
+	switch {
+	case ws.Answers.Contains(word):
+		return TierAnswer
+	case ws.Words.Contains(word):
+		return TierGuess
+	default:
+		return ""
+	}
+}
+
+// SetTier promotes a valid guess to an answer or demotes an answer to a
+// guess-only word. Daily words keep their place in the daily schedule either
+// way, so today's puzzle never changes under players' feet.
+func (ws *WordSet) SetTier(word, tier string) error {
+	word = ws.Normalize(word)
+	if !ws.Words.Contains(word) {
+		return ErrWordNotFound
 	}
 
-	var filteredWords []string
-	for _, word := range dailyList {
-		if len(word) == size {
-			filteredWords = append(filteredWords, word)
-		}
+	var err error
+	switch tier {
+	case TierAnswer:
+		err = ws.Answers.Add(word)
+	case TierGuess:
+		err = ws.Answers.Remove(word)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownTier, tier)
 	}
+	// Setting the tier a word already has is not an error
+	if errors.Is(err, ErrWordExists) || errors.Is(err, ErrWordNotFound) {
+		return nil
+	}
+	return err
+}
+
+// RandomWord draws an answer of the given size at random.
+func (ws *WordSet) RandomWord(size int) (string, error) {
+	filteredWords := ws.Answers.Words(size)
 
 	if len(filteredWords) == 0 {
-		return "", errors.New("no words found with the specified size")
+		return "", ErrNoWordsOfSize
 	}
-
-	randomIndex := rng.Intn(len(filteredWords))
-	return filteredWords[randomIndex], nil
+	return filteredWords[rand.Intn(len(filteredWords))], nil
 }
 
-// AddNewWord adds a new word to words.txt and updates the in-memory wordList.
-// It ensures that the word is not already present and is alphabetic.
-func AddNewWord(newWord string) error {
-	newWord = strings.TrimSpace(strings.ToLower(newWord))
-	if newWord == "" {
-		return errors.New("word cannot be empty")
+// AnswerAt returns the answer of the given size at index, wrapping around
+// the list, so the same seed always names the same answer.
+func (ws *WordSet) AnswerAt(size int, index int64) (string, error) {
+	filteredWords := ws.Answers.Words(size)
+
+	if len(filteredWords) == 0 {
+		return "", ErrNoWordsOfSize
+	}
+	i := index % int64(len(filteredWords))
+	if i < 0 {
+		i += int64(len(filteredWords))
 	}
+	return filteredWords[i], nil
+}
 
-	if !isAlphabetic(newWord) {
-		return errors.New("word must contain only alphabetic characters")
+// RandomWords picks n distinct answers of the given size, as multi-board
+// games need one target per board. The same seed always yields the same
+// words; a zero seed picks at random.
+func (ws *WordSet) RandomWords(size, n int, seed int64) ([]string, error) {
+	filteredWords := ws.Answers.Words(size)
+
+	if len(filteredWords) == 0 {
+		return nil, ErrNoWordsOfSize
+	}
+	if len(filteredWords) < n {
+		return nil, ErrNotEnoughWords
 	}
 
-	for _, word := range wordList {
-		if word == newWord {
-			return errors.New("word already exists in the list")
-		}
+	if seed == 0 {
+		seed = time.Now().UnixNano()
 	}
+	rng := rand.New(rand.NewSource(seed))
 
-	pwd, err := os.Getwd()
-	if err != nil {
-		return errors.New("failed to open words.txt: " + err.Error())
+	words := make([]string, n)
+	for i, j := range rng.Perm(len(filteredWords))[:n] {
+		words[i] = filteredWords[j]
 	}
-	filePath := filepath.Join(pwd, "../../internal/utils/words.txt")
-	file, err := os.OpenFile(filePath, os.O_APPEND|os.O_WRONLY, 0644)
-	if err != nil {
-		return errors.New("failed to open words.txt: " + err.Error())
+	return words, nil
+}
+
+// DailyWord returns a daily word of the given size. Without a seed it is
+// today's word on the default UTC schedule, so every caller gets the same word
+// on the same day.
+func (ws *WordSet) DailyWord(size int, seed int64) (string, error) {
+	if seed == 0 {
+		_, word, err := defaultDailySchedule.Today(ws, size)
+		return word, err
 	}
-	defer file.Close()
+	rng := rand.New(rand.NewSource(seed))
+
+	filteredWords := ws.Daily.Words(size)
 
-	if _, err := file.WriteString(newWord + "\n"); err != nil {
-		return errors.New("failed to write to words.txt: " + err.Error())
+	if len(filteredWords) == 0 {
+		return "", ErrNoWordsOfSize
 	}
 
-	wordList = append(wordList, newWord)
-	return nil
+	randomIndex := rng.Intn(len(filteredWords))
+	return filteredWords[randomIndex], nil
 }
 
-// isAlphabetic checks if a string contains only alphabetic characters.
-func isAlphabetic(s string) bool {
-	for _, r := range s {
-		if !('a' <= r && r <= 'z') && !('A' <= r && r <= 'Z') {
-			return false
-		}
+func GetRandomWord(size int) (string, error) {
+	return defaultWordSet().RandomWord(size)
+}
+
+// WordsOfSize returns a copy of every known word with the given length.
+func WordsOfSize(size int) []string {
+	return defaultWordSet().WordsOfSize(size)
+}
+
+func IsValidWord(word string) bool {
+	return defaultWordSet().IsValidWord(word)
+}
+
+func GetDailyWord(size int, seed int64) (string, error) {
+	return defaultWordSet().DailyWord(size, seed)
+}
+
+// AddWord adds a new guess-only word to the in-memory word list. It ensures
+// that the word is not already present and only uses the language's letters.
+func (ws *WordSet) AddWord(newWord string) error {
+	newWord = ws.Normalize(newWord)
+	if newWord == "" {
+		return ErrEmptyWord
 	}
-	return true
+
+	if !ws.Language.IsWord(newWord) {
+		return ErrForeignLetters
+	}
+
+	return ws.Words.Add(newWord)
 }
@@ -1,43 +1,32 @@
 package utils
 
 import (
-	"io/ioutil"
-	"os"
-	"path/filepath"
 	"strings"
 	"testing"
 )
 
 func TestGetRandomWord(t *testing.T) {
-	// Setup: Initialize the wordList with sample data
-	wordList = []string{"apple", "banana", "grape", "orange", "berry", "melon"}
+	// Setup: Initialize the English answer list with sample data
+	defaultWordSet().Answers = NewDictionary([]string{"apple", "banana", "grape", "orange", "berry", "melon"})
 
 	tests := []struct {
 		name        string
 		size        int
-		seed        int64
-		expected    string
 		expectError bool
 	}{
 		{
-			name:        "Valid size with exact seed",
+			name:        "Valid size",
 			size:        6,
-			seed:        0,
-			expected:    "banana",
 			expectError: false,
 		},
 		{
-			name:        "Valid size without seed (random)",
+			name:        "Valid size with more words",
 			size:        5,
-			seed:        0,  // Indicating random seed
-			expected:    "", // Cannot predict; check length
 			expectError: false,
 		},
 		{
 			name:        "No words of specified size",
 			size:        10,
-			seed:        0,
-			expected:    "",
 			expectError: true,
 		},
 	}
@@ -45,7 +34,7 @@ func TestGetRandomWord(t *testing.T) {
 	for _, tt := range tests {
 		tt := tt // Capture range variable
 		t.Run(tt.name, func(t *testing.T) {
-			word, err := GetRandomWord(tt.size, tt.seed)
+			word, err := GetRandomWord(tt.size)
 			if tt.expectError {
 				if err == nil {
 					t.Errorf("Expected error for size %d, but got none", tt.size)
@@ -54,23 +43,55 @@ func TestGetRandomWord(t *testing.T) {
 				if err != nil {
 					t.Errorf("Unexpected error for size %d: %v", tt.size, err)
 				}
-				if tt.expected != "" {
-					if word != tt.expected {
-						t.Errorf("Expected word %q, got %q", tt.expected, word)
-					}
-				} else {
-					if len(word) != tt.size {
-						t.Errorf("Expected word length %d, got %d", tt.size, len(word))
-					}
+				if len(word) != tt.size {
+					t.Errorf("Expected word length %d, got %d", tt.size, len(word))
 				}
 			}
 		})
 	}
 }
 
+func TestAnswerAt(t *testing.T) {
+	ws := &WordSet{Answers: NewDictionary([]string{"apple", "banana", "grape", "orange", "berry", "melon"})}
+
+	tests := []struct {
+		name        string
+		size        int
+		index       int64
+		expected    string
+		expectError bool
+	}{
+		{name: "First answer", size: 5, index: 0, expected: "apple"},
+		{name: "Later answer", size: 5, index: 1, expected: "grape"},
+		{name: "Index past the end wraps", size: 5, index: 4, expected: "apple"},
+		{name: "Large index", size: 6, index: 1 << 40, expected: "banana"},
+		{name: "Negative index wraps", size: 5, index: -1, expected: "melon"},
+		{name: "No words of specified size", size: 10, index: 0, expectError: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			word, err := ws.AnswerAt(tt.size, tt.index)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("AnswerAt(%d, %d) returned no error", tt.size, tt.index)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AnswerAt(%d, %d) returned error: %v", tt.size, tt.index, err)
+			}
+			if word != tt.expected {
+				t.Errorf("AnswerAt(%d, %d) = %q; want %q", tt.size, tt.index, word, tt.expected)
+			}
+		})
+	}
+}
+
 func TestIsValidWord(t *testing.T) {
-	// Setup: Initialize the wordList with sample data
-	wordList = []string{"apple", "banana", "grape", "orange", "berry", "melon"}
+	// Setup: Initialize the English word list with sample data
+	defaultWordSet().Words = NewDictionary([]string{"apple", "banana", "grape", "orange", "berry", "melon"})
 
 	tests := []struct {
 		name     string
@@ -111,8 +132,8 @@ func TestIsValidWord(t *testing.T) {
 }
 
 func TestGetDailyWord(t *testing.T) {
-	// Setup: Initialize the dailyList with sample data
-	dailyList = []string{"sunny", "cloudy", "rainy", "stormy", "windy"}
+	// Setup: Initialize the English daily list with sample data
+	defaultWordSet().Daily = NewDictionary([]string{"sunny", "cloudy", "rainy", "stormy", "windy"})
 
 	tests := []struct {
 		name        string
@@ -170,40 +191,10 @@ func TestGetDailyWord(t *testing.T) {
 	}
 }
 
-func TestAddNewWord(t *testing.T) {
-	// Setup: Initialize the wordList with sample data
-	wordList = []string{"apple", "banana", "grape"}
-
-	// Create a temporary directory to simulate the file system
-	tempDir := t.TempDir()
-	originalWd, err := os.Getwd()
-	if err != nil {
-		t.Fatalf("Failed to get current working directory: %v", err)
-	}
-
-	// Change the working directory to the temporary directory
-	err = os.Chdir(tempDir)
-	if err != nil {
-		t.Fatalf("Failed to change working directory: %v", err)
-	}
-	defer func() {
-		// Restore the original working directory after the test
-		os.Chdir(originalWd)
-	}()
-
-	// Create a mock words.txt file in the expected path
-	// Considering the AddNewWord constructs the path as "../../internal/utils/words.txt"
-	mockPath := filepath.Join(tempDir, "../../internal/utils")
-	err = os.MkdirAll(mockPath, os.ModePerm)
-	if err != nil {
-		t.Fatalf("Failed to create mock directory: %v", err)
-	}
-
-	wordsFilePath := filepath.Join(mockPath, "words.txt")
-	err = ioutil.WriteFile(wordsFilePath, []byte("apple\nbanana\ngrape\n"), 0644)
-	if err != nil {
-		t.Fatalf("Failed to create mock words.txt: %v", err)
-	}
+func TestAddWord(t *testing.T) {
+	// Setup: Initialize the English word list with sample data
+	ws := defaultWordSet()
+	ws.Words = NewDictionary([]string{"apple", "banana", "grape"})
 
 	tests := []struct {
 		name        string
@@ -220,19 +211,25 @@ func TestAddNewWord(t *testing.T) {
 		{
 			name:        "Add existing word",
 			newWord:     "apple",
-			expected:    []string{"apple", "banana", "grape"},
+			expected:    []string{"apple", "banana", "grape", "kiwi"},
 			expectError: true,
 		},
 		{
 			name:        "Add empty string",
 			newWord:     "",
-			expected:    []string{"apple", "banana", "grape"},
+			expected:    []string{"apple", "banana", "grape", "kiwi"},
 			expectError: true,
 		},
 		{
 			name:        "Add word with non-alphabetic characters",
 			newWord:     "kiwi123",
-			expected:    []string{"apple", "banana", "grape"},
+			expected:    []string{"apple", "banana", "grape", "kiwi"},
+			expectError: true,
+		},
+		{
+			name:        "Add word with letters outside the alphabet",
+			newWord:     "größe",
+			expected:    []string{"apple", "banana", "grape", "kiwi"},
 			expectError: true,
 		},
 	}
@@ -240,40 +237,172 @@ func TestAddNewWord(t *testing.T) {
 	for _, tt := range tests {
 		tt := tt // Capture range variable
 		t.Run(tt.name, func(t *testing.T) {
-			err := AddNewWord(tt.newWord)
+			err := ws.AddWord(tt.newWord)
 			if tt.expectError {
 				if err == nil {
 					t.Errorf("Expected error when adding word %q, but got none", tt.newWord)
 				}
-			} else {
-				if err != nil {
-					t.Errorf("Unexpected error when adding word %q: %v", tt.newWord, err)
-				} else {
-					// Verify that the word was added to wordList
-					if len(wordList) != len(tt.expected) {
-						t.Errorf("wordList length = %d; want %d", len(wordList), len(tt.expected))
-					}
-					for i, word := range tt.expected {
-						if wordList[i] != word {
-							t.Errorf("wordList[%d] = %q; want %q", i, wordList[i], word)
-						}
-					}
+			} else if err != nil {
+				t.Errorf("Unexpected error when adding word %q: %v", tt.newWord, err)
+			}
 
-					// Verify that the word was added to words.txt
-					content, err := ioutil.ReadFile(wordsFilePath)
-					if err != nil {
-						t.Errorf("Failed to read words.txt: %v", err)
-					}
-					lines := strings.Split(strings.TrimSpace(string(content)), "\n")
-					if len(lines) != len(tt.expected) {
-						t.Errorf("words.txt line count = %d; want %d", len(lines), len(tt.expected))
-					}
-					for i, word := range tt.expected {
-						if lines[i] != word {
-							t.Errorf("words.txt line %d = %q; want %q", i, lines[i], word)
-						}
-					}
+			// Verify the in-memory word list
+			all := ws.Words.All()
+			if len(all) != len(tt.expected) {
+				t.Errorf("word list length = %d; want %d", len(all), len(tt.expected))
+			}
+			for i, word := range tt.expected {
+				if i < len(all) && all[i] != word {
+					t.Errorf("word list[%d] = %q; want %q", i, all[i], word)
+				}
+			}
+			if !ws.IsValidWord("kiwi") {
+				t.Errorf("IsValidWord(kiwi) = false after adding it")
+			}
+		})
+	}
+}
+
+func TestWordTiers(t *testing.T) {
+	// Setup: Initialize the English word lists with sample data
+	ws := defaultWordSet()
+	ws.Words = NewDictionary([]string{"apple", "banana", "grape", "fjord", "xylyl"})
+	ws.Answers = NewDictionary([]string{"apple", "grape"})
+
+	tests := []struct {
+		name        string
+		word        string
+		tier        string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "Promote a guess-only word",
+			word:     "fjord",
+			tier:     TierAnswer,
+			expected: TierAnswer,
+		},
+		{
+			name:     "Demote an answer",
+			word:     "GRAPE",
+			tier:     TierGuess,
+			expected: TierGuess,
+		},
+		{
+			name:     "Promote an answer again",
+			word:     "apple",
+			tier:     TierAnswer,
+			expected: TierAnswer,
+		},
+		{
+			name:        "Unknown word",
+			word:        "kiwi",
+			tier:        TierAnswer,
+			expected:    "",
+			expectError: true,
+		},
+		{
+			name:        "Unknown tier",
+			word:        "xylyl",
+			tier:        "secret",
+			expected:    TierGuess,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			err := ws.SetTier(tt.word, tt.tier)
+			if tt.expectError && err == nil {
+				t.Errorf("Expected error when setting %q to %q, but got none", tt.word, tt.tier)
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Unexpected error when setting %q to %q: %v", tt.word, tt.tier, err)
+			}
+			if got := ws.Tier(tt.word); got != tt.expected {
+				t.Errorf("Tier(%q) = %q; want %q", tt.word, got, tt.expected)
+			}
+		})
+	}
+
+	// Only answers are picked as targets
+	for i := 0; i < 20; i++ {
+		word, err := GetRandomWord(5)
+		if err != nil {
+			t.Fatalf("GetRandomWord returned error: %v", err)
+		}
+		if ws.Tier(word) != TierAnswer {
+			t.Errorf("GetRandomWord returned guess-only word %q", word)
+		}
+	}
+}
+
+func TestRandomWords(t *testing.T) {
+	ws := &WordSet{Answers: NewDictionary([]string{"apple", "grape", "berry", "melon", "lemon", "banana"})}
+
+	tests := []struct {
+		name        string
+		size        int
+		n           int
+		seed        int64
+		expectError bool
+	}{
+		{
+			name: "Two targets",
+			size: 5,
+			n:    2,
+			seed: 7,
+		},
+		{
+			name: "Every word of the size",
+			size: 5,
+			n:    5,
+			seed: 7,
+		},
+		{
+			name:        "More targets than words",
+			size:        5,
+			n:           6,
+			seed:        7,
+			expectError: true,
+		},
+		{
+			name:        "No words of specified size",
+			size:        8,
+			n:           2,
+			seed:        7,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			words, err := ws.RandomWords(tt.size, tt.n, tt.seed)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error for %d words of size %d, but got none", tt.n, tt.size)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error for %d words of size %d: %v", tt.n, tt.size, err)
+			}
+			if len(words) != tt.n {
+				t.Errorf("Expected %d words, got %d", tt.n, len(words))
+			}
+			seen := map[string]bool{}
+			for _, word := range words {
+				if seen[word] || len(word) != tt.size {
+					t.Errorf("Expected distinct words of length %d, got %v", tt.size, words)
 				}
+				seen[word] = true
+			}
+
+			again, _ := ws.RandomWords(tt.size, tt.n, tt.seed)
+			if strings.Join(again, ",") != strings.Join(words, ",") {
+				t.Errorf("Same seed picked %v, then %v", words, again)
 			}
 		})
 	}